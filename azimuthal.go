@@ -7,19 +7,51 @@ import (
 // An ancient azimuthal conformal projection (on spheres only, not ellipsoids) which is often used
 // for rendering planets to maintain shape of craters. Diverges as latitude approaches Pi/2.
 // https://en.wikipedia.org/wiki/Stereographic_map_projection
-type Stereographic struct{}
+type Stereographic struct {
+	// The latitude (in radians) at which the scale is true (undistorted). Zero gives true scale at the equator,
+	// Pi/2 gives true scale at the center of the projection.
+	TrueScaleLat float64
+	// Whether the projection is centered on the south pole rather than the north pole.
+	South bool
+}
 
 func NewStereographic() Stereographic {
 	return Stereographic{}
 }
 
+// Construct a polar stereographic projection with true scale along the given standard parallel (in radians),
+// centered on the pole of the same hemisphere as the parallel. Corresponds to EPSG's polar stereographic variant B.
+func NewPolarStereographic(standardParallel float64) Stereographic {
+	return Stereographic{TrueScaleLat: math.Abs(standardParallel), South: math.Signbit(standardParallel)}
+}
+
+// Construct a polar stereographic projection with the given scale factor at the pole, which must be between 0.5
+// and 1. Corresponds to EPSG's polar stereographic variant A.
+func NewPolarStereographicScale(k0 float64, south bool) Stereographic {
+	return Stereographic{TrueScaleLat: math.Asin(2*k0 - 1), South: south}
+}
+
+// The scale factor at the center of the projection.
+func (s Stereographic) ScaleFactor() float64 {
+	return (1 + math.Sin(s.TrueScaleLat)) / 2
+}
+
 func (s Stereographic) Project(latitude float64, longitude float64) (float64, float64) {
-	r := 1 / math.Tan(latitude/2+math.Pi/4)
+	if s.South {
+		latitude = -latitude
+	}
+	r := 2 * s.ScaleFactor() / math.Tan(latitude/2+math.Pi/4)
+	if s.South {
+		return r * math.Sin(longitude), r * math.Cos(longitude)
+	}
 	return r * math.Sin(longitude), -r * math.Cos(longitude)
 }
 
 func (s Stereographic) Inverse(x float64, y float64) (float64, float64) {
-	return math.Pi/2 - 2*math.Atan(math.Hypot(x, y)), math.Atan2(x, -y)
+	if s.South {
+		return -(math.Pi/2 - 2*math.Atan(math.Hypot(x, y)/(2*s.ScaleFactor()))), math.Atan2(x, y)
+	}
+	return math.Pi/2 - 2*math.Atan(math.Hypot(x, y)/(2*s.ScaleFactor())), math.Atan2(x, -y)
 }
 
 func (s Stereographic) PlanarBounds() Bounds {
@@ -31,6 +63,19 @@ func (s Stereographic) PlanarBounds() Bounds {
 	}
 }
 
+func (s Stereographic) Describe() Metadata {
+	return Metadata{
+		Name:       "Stereographic",
+		Aliases:    []string{"Polar stereographic"},
+		Family:     FamilyAzimuthal,
+		Properties: Conformal | Azimuthal,
+		Shape:      ShapeUnbounded,
+		Extent:     ExtentHemisphere,
+		EPSGMethod: 9810,
+		PROJName:   "stere",
+	}
+}
+
 // An ancient equidistant azimuthal projection.
 // https://en.wikipedia.org/wiki/Azimuthal_equidistant_projection
 type Polar struct{}
@@ -52,6 +97,18 @@ func (p Polar) PlanarBounds() Bounds {
 	return NewCircleBounds(math.Pi)
 }
 
+func (p Polar) Describe() Metadata {
+	return Metadata{
+		Name:       "Azimuthal equidistant",
+		Aliases:    []string{"Polar", "Postel"},
+		Family:     FamilyAzimuthal,
+		Properties: Equidistant | Azimuthal,
+		Shape:      ShapeCircle,
+		Extent:     ExtentWorld,
+		PROJName:   "aeqd",
+	}
+}
+
 // An equal-area azimuthal projection.
 // https://en.wikipedia.org/wiki/Lambert_azimuthal_equal-area_projection
 type LambertAzimuthal struct{}
@@ -75,6 +132,18 @@ func (l LambertAzimuthal) PlanarBounds() Bounds {
 	return NewCircleBounds(1)
 }
 
+func (l LambertAzimuthal) Describe() Metadata {
+	return Metadata{
+		Name:       "Lambert azimuthal equal-area",
+		Family:     FamilyAzimuthal,
+		Properties: EqualArea | Azimuthal,
+		Shape:      ShapeCircle,
+		Extent:     ExtentHemisphere,
+		EPSGMethod: 1027,
+		PROJName:   "laea",
+	}
+}
+
 // An ancient projection in which all great cirlces are straight lines. Many use cases
 // but rapidly distorts the further away from the center of the projection.
 // https://en.wikipedia.org/wiki/Gnomonic_projection
@@ -97,6 +166,18 @@ func (g Gnomonic) PlanarBounds() Bounds {
 	return NewRectangleBounds(4, 4)
 }
 
+func (g Gnomonic) Describe() Metadata {
+	return Metadata{
+		Name:       "Gnomonic",
+		Aliases:    []string{"Gnomic"},
+		Family:     FamilyAzimuthal,
+		Properties: Azimuthal | StraightGreatCircles,
+		Shape:      ShapeUnbounded,
+		Extent:     ExtentRegional,
+		PROJName:   "gnom",
+	}
+}
+
 // A projection of a hemisphere of a sphere as if viewed from an infinite distance away.
 // https://en.wikipedia.org/wiki/Orthographic_map_projection
 type Orthographic struct{}
@@ -117,6 +198,18 @@ func (o Orthographic) PlanarBounds() Bounds {
 	return NewCircleBounds(1)
 }
 
+func (o Orthographic) Describe() Metadata {
+	return Metadata{
+		Name:       "Orthographic",
+		Family:     FamilyAzimuthal,
+		Properties: Azimuthal,
+		Shape:      ShapeCircle,
+		Extent:     ExtentHemisphere,
+		EPSGMethod: 9840,
+		PROJName:   "ortho",
+	}
+}
+
 // A projection of that mimics the actual appearance of the earth from a fixed viewing distance.
 // https://en.wikipedia.org/wiki/General_Perspective_projection
 type VerticalPerspective struct {
@@ -168,6 +261,19 @@ func (p VerticalPerspective) PlanarBounds() Bounds {
 	return NewCircleBounds(math.Sqrt((p.D - 1) / (p.D + 1)))
 }
 
+func (p VerticalPerspective) Describe() Metadata {
+	return Metadata{
+		Name:       "Vertical perspective",
+		Aliases:    []string{"General perspective", "Near-sided perspective"},
+		Family:     FamilyAzimuthal,
+		Properties: Azimuthal,
+		Shape:      ShapeCircle,
+		Extent:     ExtentHemisphere,
+		EPSGMethod: 9838,
+		PROJName:   "nsper",
+	}
+}
+
 // A projection of that mimics the actual appearance of the sphere from a fixed viewing distance, centered at the given
 // latitude and longitude. Could be equivalently represented using an oblique transform of VerticalPerspective, but this is more efficient.
 type ObliqueVerticalPerspective struct {
@@ -217,3 +323,120 @@ func (p ObliqueVerticalPerspective) PlanarBounds() Bounds {
 	}
 	return NewCircleBounds(math.Sqrt((p.D - 1) / (p.D + 1)))
 }
+
+func (p ObliqueVerticalPerspective) Describe() Metadata {
+	return Metadata{
+		Name:       "Oblique vertical perspective",
+		Aliases:    []string{"General perspective", "Near-sided perspective"},
+		Family:     FamilyAzimuthal,
+		Aspect:     AspectOblique,
+		Properties: Azimuthal,
+		Shape:      ShapeCircle,
+		Extent:     ExtentHemisphere,
+		EPSGMethod: 9838,
+		PROJName:   "nsper",
+	}
+}
+
+// A perspective view of the sphere from a viewpoint above the given camera location, tilted away from looking
+// straight down toward the given azimuth, as seen by a camera on an aircraft or satellite. The image is turned
+// so that the direction of the tilt is up, and is limited to a circular field of view around the optical
+// axis. Locations which are hidden beyond the horizon, behind the viewpoint or outside the field of view
+// project to NaN, as do planar points whose line of sight misses the sphere in the inverse. With no tilt or
+// azimuth, this is the ObliqueVerticalPerspective projection with D equal to 1 + Height.
+// https://en.wikipedia.org/wiki/General_Perspective_projection
+type TiltedPerspective struct {
+	CameraLat float64 // The latitude (in radians) of the location directly below the viewpoint.
+	CameraLon float64 // The longitude (in radians) of the location directly below the viewpoint.
+	Height    float64 // The height of the viewpoint above the surface, in multiples of the sphere radius.
+	Tilt      float64 // The angle (in radians) of the optical axis away from straight down, less than Pi/2.
+	Azimuth   float64 // The direction (in radians clockwise from north) toward which the view is tilted.
+	FOV       float64 // The full angle (in radians) of the field of view, where Pi or more sees everything in front.
+
+	rotation Rotation // cached rotation moving the camera location to (0, 0) with the azimuth due north
+}
+
+func NewTiltedPerspective(camLat float64, camLon float64, height float64, tilt float64, azimuth float64, fov float64) TiltedPerspective {
+	if height <= 0 {
+		panic("the viewpoint of a TiltedPerspective projection must be above the surface")
+	}
+	if tilt < 0 || tilt >= math.Pi/2 {
+		panic("the tilt of a TiltedPerspective projection must be at least 0 and less than Pi/2")
+	}
+	return TiltedPerspective{
+		CameraLat: camLat,
+		CameraLon: camLon,
+		Height:    height,
+		Tilt:      tilt,
+		Azimuth:   azimuth,
+		FOV:       fov,
+		rotation:  NewRotationFromCenter(camLat, camLon, azimuth),
+	}
+}
+
+func (p TiltedPerspective) Project(latitude float64, longitude float64) (float64, float64) {
+	// the viewpoint is at (d, 0, 0) once the camera location is rotated to (0, 0)
+	d := 1 + p.Height
+	px, py, pz := p.rotation.ApplyVector(sphericalToCartesian(latitude, longitude))
+	if px*d < 1 {
+		return math.NaN(), math.NaN()
+	}
+	sinTilt, cosTilt := math.Sincos(p.Tilt)
+	depth := cosTilt*(d-px) + sinTilt*pz
+	if depth <= 0 {
+		return math.NaN(), math.NaN()
+	}
+	x := p.Height * py / depth
+	y := p.Height * (cosTilt*pz - sinTilt*(d-px)) / depth
+	if !p.PlanarBounds().Within(x, y) {
+		return math.NaN(), math.NaN()
+	}
+	return x, y
+}
+
+func (p TiltedPerspective) Inverse(x float64, y float64) (float64, float64) {
+	if !p.PlanarBounds().Within(x, y) {
+		return math.NaN(), math.NaN()
+	}
+	d := 1 + p.Height
+	sinTilt, cosTilt := math.Sincos(p.Tilt)
+	// the line of sight, from the viewpoint toward the point on the image plane
+	sx := y*sinTilt - p.Height*cosTilt
+	sy := x
+	sz := y*cosTilt + p.Height*sinTilt
+	along := d * sx
+	length2 := sx*sx + sy*sy + sz*sz
+	disc := math.Max(0, along*along-length2*(d*d-1))
+	// the nearer of the two intersections with the sphere, without cancellation
+	s := (d*d - 1) / (math.Sqrt(disc) - along)
+	return cartesianToSpherical(p.rotation.ApplyInverseVector(d+s*sx, s*sy, s*sz))
+}
+
+func (p TiltedPerspective) PlanarBounds() Bounds {
+	radius := math.Inf(1)
+	if p.FOV < math.Pi {
+		radius = p.Height * math.Tan(p.FOV/2)
+	}
+	return NewHorizonBounds(p.Height, 1+p.Height, p.Tilt, radius)
+}
+
+func (p TiltedPerspective) Describe() Metadata {
+	shape := ShapeOther
+	if p.FOV >= math.Pi {
+		switch {
+		case p.Tilt == 0:
+			shape = ShapeCircle
+		case p.Tilt+math.Asin(1/(1+p.Height)) < math.Pi/2:
+			shape = ShapeEllipse
+		}
+	}
+	return Metadata{
+		Name:     "Tilted perspective",
+		Aliases:  []string{"Satellite view"},
+		Family:   FamilyAzimuthal,
+		Aspect:   AspectOblique,
+		Shape:    shape,
+		Extent:   ExtentHemisphere,
+		PROJName: "tpers",
+	}
+}
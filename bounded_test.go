@@ -41,6 +41,14 @@ func FuzzGallStereographicProjectBounded(f *testing.F) {
 	projectionBoundedFuzz(f, NewGallStereographic())
 }
 
+func FuzzMercatorTrueScaleProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewMercatorTrueScale(60*math.Pi/180))
+}
+
+func FuzzMillerTrueScaleProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewMillerTrueScale(60*math.Pi/180))
+}
+
 func FuzzMillerProjectBounded(f *testing.F) {
 	projectionBoundedFuzz(f, NewMiller())
 }
@@ -49,6 +57,14 @@ func FuzzCentralProjectBounded(f *testing.F) {
 	projectionBoundedFuzz(f, NewCentral())
 }
 
+func FuzzAlbersProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewAlbers(29.5*math.Pi/180, 45.5*math.Pi/180))
+}
+
+func FuzzAlbersSouthProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewAlbers(-60*math.Pi/180, -20*math.Pi/180))
+}
+
 func FuzzSinusoidalProjectBounded(f *testing.F) {
 	projectionBoundedFuzz(f, NewSinusoidal())
 }
@@ -69,10 +85,18 @@ func FuzzHomolosineProjectBounded(f *testing.F) {
 //	projectionBoundedFuzz(f, NewEckertIV())
 //}
 
+func FuzzLoximuthalProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewLoximuthal(40*math.Pi/180))
+}
+
 func FuzzStereographicProjectBounded(f *testing.F) {
 	projectionBoundedFuzz(f, NewStereographic())
 }
 
+func FuzzMeridianShiftMollweideProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewMeridianShift(NewMollweide(), -2))
+}
+
 func FuzzPolarProjectBounded(f *testing.F) {
 	projectionBoundedFuzz(f, NewPolar())
 }
@@ -121,6 +145,34 @@ func FuzzLagrangeProjectBounded(f *testing.F) {
 	projectionBoundedFuzz(f, NewLagrange())
 }
 
+func FuzzSnyderIcosahedralProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewSnyderIcosahedral())
+}
+
+func FuzzQuadrilateralizedCubeProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewQuadrilateralizedCube())
+}
+
+func FuzzPeirceQuincuncialProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewPeirceQuincuncial())
+}
+
+func FuzzGuyouProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewGuyou())
+}
+
+func FuzzAdamsHemisphereInASquareProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewAdamsHemisphereInASquare())
+}
+
+func FuzzAdamsWorldInASquareIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewAdamsWorldInASquareI())
+}
+
+func FuzzAdamsWorldInASquareIIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewAdamsWorldInASquareII())
+}
+
 func projectionBoundedFuzz(f *testing.F, proj Projection) {
 	f.Add(109.95574287564276, 17.0)
 	f.Add(-15.707963267948964, -0.09817477042468103)
@@ -139,3 +191,141 @@ func projectionBoundedFuzz(f *testing.F, proj Projection) {
 		}
 	})
 }
+
+func FuzzLeeTetrahedralProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewLeeTetrahedral())
+}
+
+func FuzzLeeTetrahedralRectangleProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewLeeTetrahedralRectangle())
+}
+
+func FuzzConformalIcosahedralProjectBounded(f *testing.F) {
+	// the middle of an outer edge of the net, on the equator
+	f.Add(math.Pi/2, 0.3141592653589794)
+	projectionBoundedFuzz(f, NewConformalIcosahedral())
+}
+
+func FuzzVanDerGrintenIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewVanDerGrintenI())
+}
+
+func FuzzVanDerGrintenIIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewVanDerGrintenII())
+}
+
+func FuzzVanDerGrintenIIIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewVanDerGrintenIII())
+}
+
+func FuzzVanDerGrintenIVProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewVanDerGrintenIV())
+}
+
+func FuzzNicolosiGlobularProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewNicolosiGlobular())
+}
+
+func FuzzApianGlobularProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewApianGlobular())
+}
+
+func FuzzBaconGlobularProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewBaconGlobular())
+}
+
+func FuzzOrteliusOvalProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewOrteliusOval())
+}
+
+func FuzzEckertIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewEckertI())
+}
+
+func FuzzEckertIIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewEckertII())
+}
+
+func FuzzEckertIIIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewEckertIII())
+}
+
+func FuzzEckertVProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewEckertV())
+}
+
+func FuzzEckertVIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewEckertVI())
+}
+
+func FuzzWagnerIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewWagnerI())
+}
+
+func FuzzWagnerIIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewWagnerII())
+}
+
+func FuzzWagnerIIIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewWagnerIII(0))
+}
+
+func FuzzWagnerIVProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewWagnerIV())
+}
+
+func FuzzWagnerVProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewWagnerV())
+}
+
+func FuzzWagnerVIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewWagnerVI())
+}
+
+func FuzzWagnerVIIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewWagnerVII())
+}
+
+func FuzzWagnerVIIIProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewWagnerVIII())
+}
+
+func FuzzWagnerIXProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewWagnerIX())
+}
+
+func FuzzHammerWagnerProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewHammerWagner(math.Pi/3, math.Pi/2, 0, 2))
+}
+
+func FuzzEckertGreifendorffProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewEckertGreifendorff())
+}
+
+func FuzzBriesemeisterProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewBriesemeister())
+}
+
+func FuzzStretchedAzimuthalProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewStretchedAzimuthal(NewLambertAzimuthal(), 2))
+}
+
+func FuzzStretchedAzimuthalLuneProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewStretchedAzimuthal(NewPolar(), 1.5))
+}
+
+func FuzzLagrangeGeneralProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewLagrangeGeneral(1.5, math.Pi/6))
+}
+
+func FuzzLagrangeNarrowProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewLagrangeGeneral(3, -math.Pi/4))
+}
+
+func FuzzTwoPointEquidistantProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewTwoPointEquidistant(0.2, -1.5, 0.8, 2.0))
+}
+
+func FuzzChamberlinAfricaProjectBounded(f *testing.F) {
+	projectionBoundedFuzz(f, NewChamberlinAfrica())
+}
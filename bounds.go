@@ -1,5 +1,7 @@
 package flatsphere
 
+import "math"
+
 type Bounds interface {
 	Width() float64
 	Height() float64
@@ -94,3 +96,197 @@ func (e EllipseBounds) Height() float64 {
 func (e EllipseBounds) Within(x float64, y float64) bool {
 	return (x*x)/(e.SemiaxisX*e.SemiaxisX)+(y*y)/(e.SemiaxisY*e.SemiaxisY) <= 1
 }
+
+// Represents the region of the image plane of a perspective view in which the sphere is seen, limited by the
+// horizon and by a circular field of view centered on the origin. The optical axis passes through the origin,
+// and the direction to the center of the sphere is tilted from it toward negative y. The horizon is an ellipse
+// when all of it lies in front of the viewpoint, and otherwise the region is unbounded toward negative y unless
+// the field of view limits it.
+type HorizonBounds struct {
+	Focal   float64 // The distance from the viewpoint to the image plane.
+	Tilt    float64 // The angle (in radians) between the optical axis and the direction to the center of the sphere.
+	Horizon float64 // The angle (in radians) between the direction to the center of the sphere and the horizon.
+	Radius  float64 // The radius of the field of view on the image plane, or +Inf for no limit.
+}
+
+// Construct the bounds of a perspective view from the given distance to the center of the sphere, in multiples
+// of its radius, with the image plane at the given focal length from the viewpoint.
+func NewHorizonBounds(focal float64, distance float64, tilt float64, radius float64) HorizonBounds {
+	return HorizonBounds{
+		Focal:   focal,
+		Tilt:    tilt,
+		Horizon: math.Asin(1 / distance),
+		Radius:  radius,
+	}
+}
+
+// The rectangle containing both the horizon and the field of view, which is larger than the region itself
+// when the field of view cuts the horizon.
+func (h HorizonBounds) Extent() RectangleBounds {
+	extent := RectangleBounds{XMin: math.Inf(-1), XMax: math.Inf(1), YMin: math.Inf(-1), YMax: math.Inf(1)}
+	if h.Tilt < h.Horizon+math.Pi/2 {
+		extent.YMax = h.Focal * math.Tan(h.Horizon-h.Tilt)
+	}
+	if h.Tilt+h.Horizon < math.Pi/2 {
+		// the planes tangent to the cone of the horizon through the lines x = ±c and y = c on the image plane
+		halfWidth := h.Focal * math.Sin(h.Horizon) / math.Sqrt(math.Cos(h.Tilt+h.Horizon)*math.Cos(h.Tilt-h.Horizon))
+		extent.XMin, extent.XMax = -halfWidth, halfWidth
+		extent.YMin = -h.Focal * math.Tan(h.Tilt+h.Horizon)
+	}
+	extent.XMin, extent.XMax = math.Max(extent.XMin, -h.Radius), math.Min(extent.XMax, h.Radius)
+	extent.YMin, extent.YMax = math.Max(extent.YMin, -h.Radius), math.Min(extent.YMax, h.Radius)
+	if extent.YMin > extent.YMax {
+		// the field of view misses the sphere
+		return RectangleBounds{}
+	}
+	return extent
+}
+
+func (h HorizonBounds) Width() float64 {
+	return h.Extent().Width()
+}
+
+func (h HorizonBounds) Height() float64 {
+	return h.Extent().Height()
+}
+
+// Determines whether the line of sight through the given point meets the sphere within the field of view.
+func (h HorizonBounds) Within(x float64, y float64) bool {
+	if x*x+y*y > h.Radius*h.Radius {
+		return false
+	}
+	sinTilt, cosTilt := math.Sincos(h.Tilt)
+	toCenter := h.Focal*cosTilt - y*sinTilt
+	cosHorizon := math.Cos(h.Horizon)
+	return toCenter > 0 && toCenter*toCenter >= (x*x+y*y+h.Focal*h.Focal)*cosHorizon*cosHorizon
+}
+
+// Represents the region of a stretched azimuthal projection (see StretchedAzimuthal) with a factor below 2,
+// which shows more than a hemisphere around the center of its azimuthal projection. The region is the image of
+// the lune of the sphere within Pi/Factor of that center, stretched horizontally by the factor, and its outline
+// is generally not an ellipse.
+type LuneBounds struct {
+	Azimuthal Projection // The azimuthal projection, in its polar aspect.
+	Factor    float64    // The factor by which longitudes are divided and the azimuthal projection stretched.
+	SemiaxisX float64    // Half the width of the region.
+	SemiaxisY float64    // Half the height of the region.
+}
+
+// Construct the bounds of the given azimuthal projection stretched by the given factor, finding the extent of
+// the region from the ends of the equator and the highest point of the outermost meridian.
+func NewLuneBounds(azimuthal Projection, factor float64) LuneBounds {
+	stretched := NewStretchedAzimuthal(azimuthal, factor)
+	// the equator is widest at its ends, or where it leaves the hemisphere around the center
+	semiaxisX, _ := stretched.Project(0, math.Pi)
+	edgeX, _ := stretched.Project(0, factor*math.Pi/2)
+	_, semiaxisY := stretched.Project(math.Pi/2, 0)
+	// golden section search for the highest point of the outermost meridian, which may rise above the pole
+	ratio := (math.Sqrt(5) - 1) / 2
+	low, high := 0.0, math.Pi/2
+	for high-low > 1e-12 {
+		a, b := high-ratio*(high-low), low+ratio*(high-low)
+		_, ya := stretched.Project(a, math.Pi)
+		_, yb := stretched.Project(b, math.Pi)
+		if ya < yb {
+			low = a
+		} else {
+			high = b
+		}
+	}
+	_, meridianY := stretched.Project((low+high)/2, math.Pi)
+	return LuneBounds{
+		Azimuthal: azimuthal,
+		Factor:    factor,
+		SemiaxisX: math.Max(semiaxisX, edgeX),
+		SemiaxisY: math.Max(semiaxisY, meridianY),
+	}
+}
+
+func (l LuneBounds) Width() float64 {
+	return 2 * l.SemiaxisX
+}
+
+func (l LuneBounds) Height() float64 {
+	return 2 * l.SemiaxisY
+}
+
+// Determines whether the given point is the image of a point in the lune, by inverting the azimuthal projection
+// and checking that the result projects back onto the given point.
+func (l LuneBounds) Within(x float64, y float64) bool {
+	lat, lon := equatorialAspect.ApplyInverse(l.Azimuthal.Inverse(x/l.Factor, y))
+	px, py := l.Azimuthal.Project(equatorialAspect.Apply(lat, lon))
+	if !(math.Hypot(px*l.Factor-x, py-y) <= 1e-9*(l.SemiaxisX+l.SemiaxisY)) {
+		return false
+	}
+	// measure the excess along the parallel, since the longitude is poorly determined near the poles
+	return math.Cos(lat)*(math.Abs(lon)-math.Pi/l.Factor) <= 1e-9
+}
+
+// Represents a region made up of one or more polygons in arbitrary units, such as the faces of an unfolded
+// polyhedron, where spherical positions are mapped to the plane. Valid planar coordinates are within any of
+// the polygons, each given by its vertices in order.
+type PolygonBounds struct {
+	Polygons [][][2]float64
+}
+
+// Construct a bounding area covering the union of the given polygons.
+func NewPolygonBounds(polygons ...[][2]float64) PolygonBounds {
+	return PolygonBounds{Polygons: polygons}
+}
+
+// The extent of the polygons' vertices along the x and y axes.
+func (p PolygonBounds) Extent() RectangleBounds {
+	extent := RectangleBounds{XMin: math.Inf(1), XMax: math.Inf(-1), YMin: math.Inf(1), YMax: math.Inf(-1)}
+	for _, polygon := range p.Polygons {
+		for _, vertex := range polygon {
+			extent.XMin = math.Min(extent.XMin, vertex[0])
+			extent.XMax = math.Max(extent.XMax, vertex[0])
+			extent.YMin = math.Min(extent.YMin, vertex[1])
+			extent.YMax = math.Max(extent.YMax, vertex[1])
+		}
+	}
+	return extent
+}
+
+func (p PolygonBounds) Width() float64 {
+	return p.Extent().Width()
+}
+
+func (p PolygonBounds) Height() float64 {
+	return p.Extent().Height()
+}
+
+// Determines whether the given point is inside, or on the edge of, any of the polygons.
+func (p PolygonBounds) Within(x float64, y float64) bool {
+	for _, polygon := range p.Polygons {
+		if polygonContains(polygon, x, y) {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether the point is inside the planar polygon by the even-odd rule, or within a tiny distance of its edges,
+// so that points projected onto a shared edge of two polygons are within at least one of them.
+func polygonContains(polygon [][2]float64, x float64, y float64) bool {
+	inside := false
+	for i := range polygon {
+		a, b := polygon[i], polygon[(i+1)%len(polygon)]
+		if segmentDistance(a, b, x, y) <= 1e-9*(math.Abs(a[0])+math.Abs(a[1])+1) {
+			return true
+		}
+		if (a[1] > y) != (b[1] > y) && x < a[0]+(y-a[1])*(b[0]-a[0])/(b[1]-a[1]) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func segmentDistance(a [2]float64, b [2]float64, x float64, y float64) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	t := 0.0
+	if length := dx*dx + dy*dy; length > 0 {
+		t = math.Max(0, math.Min(1, ((x-a[0])*dx+(y-a[1])*dy)/length))
+	}
+	return math.Hypot(x-a[0]-t*dx, y-a[1]-t*dy)
+}
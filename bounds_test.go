@@ -1,6 +1,7 @@
 package flatsphere
 
 import (
+	"math"
 	"testing"
 )
 
@@ -14,6 +15,11 @@ func TestBoundsProperties(t *testing.T) {
 		{"Circle", NewCircleBounds(1.0), 2.0, 2.0},
 		{"Ellipse", NewEllipseBounds(2.0, 3.0), 4.0, 6.0},
 		{"Rectangle", NewRectangleBounds(5.0, 1.0), 5.0, 1.0},
+		{"Polygons", NewPolygonBounds([][2]float64{{0, 0}, {2, 0}, {0, 1}}, [][2]float64{{2, 0}, {3, 2}, {2, 2}}), 3.0, 2.0},
+		{"Horizon", NewHorizonBounds(5.0, 6.0, 0, math.Inf(1)), 2 * math.Sqrt(5.0/7.0), 2 * math.Sqrt(5.0/7.0)},
+		{"TiltedHorizon", NewHorizonBounds(1.0, 2.0, math.Pi/12, math.Inf(1)), 1 / math.Sqrt(math.Cos(math.Pi/4)*math.Cos(math.Pi/12)), 1 + math.Tan(math.Pi/12)},
+		{"HorizonFieldOfView", NewHorizonBounds(1.0, 2.0, math.Pi/12, 0.5), 1.0, 0.5 + math.Tan(math.Pi/12)},
+		{"HorizonBeyondView", NewHorizonBounds(1.0, 2.0, 1.2, 0.5), 0.0, 0.0},
 	}
 
 	for _, tc := range testCases {
@@ -46,6 +52,13 @@ func TestWithin(t *testing.T) {
 		{"NegativeOutside", NewRectangleBounds(2.0, 2.0), -3.0, -3.0, false},
 		{"XAxisOutside", NewRectangleBounds(2.0, 2.0), 3.0, 0.0, false},
 		{"YAxisOutside", NewRectangleBounds(2.0, 2.0), 0.0, 3.0, false},
+		{"PolygonInside", NewPolygonBounds([][2]float64{{0, 0}, {2, 0}, {0, 1}}, [][2]float64{{2, 0}, {3, 2}, {2, 2}}), 2.5, 1.5, true},
+		{"PolygonEdge", NewPolygonBounds([][2]float64{{0, 0}, {2, 0}, {0, 1}}), 1.0, 0.5, true},
+		{"PolygonGap", NewPolygonBounds([][2]float64{{0, 0}, {2, 0}, {0, 1}}, [][2]float64{{2, 0}, {3, 2}, {2, 2}}), 1.5, 1.5, false},
+		{"HorizonInside", NewHorizonBounds(1.0, 2.0, math.Pi/12, math.Inf(1)), 0.0, 0.0, true},
+		{"HorizonSky", NewHorizonBounds(1.0, 2.0, math.Pi/12, math.Inf(1)), 0.0, 0.5, false},
+		{"HorizonBelowView", NewHorizonBounds(1.0, 2.0, math.Pi/12, math.Inf(1)), 0.0, -0.9, true},
+		{"HorizonOutsideView", NewHorizonBounds(1.0, 2.0, 0, 0.1), 0.2, 0.0, false},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,143 @@
+package flatsphere
+
+import (
+	"math"
+)
+
+// An equal-area conic projection with two standard parallels, commonly used for mid-latitude regions
+// which are wider east to west than north to south. The origin of the y coordinate is on the equator.
+// https://en.wikipedia.org/wiki/Albers_projection
+type Albers struct {
+	// The first latitude (in radians) at which the scale is true.
+	Parallel1 float64
+	// The second latitude (in radians) at which the scale is true. The two parallels may be equal, but
+	// must not be placed symmetrically about the equator.
+	Parallel2 float64
+}
+
+func NewAlbers(parallel1 float64, parallel2 float64) Albers {
+	return Albers{Parallel1: parallel1, Parallel2: parallel2}
+}
+
+// The cone constant, the squared radius constant, and the radius at the origin of the projection.
+func (a Albers) cone() (n float64, c float64, rho0 float64) {
+	n = (math.Sin(a.Parallel1) + math.Sin(a.Parallel2)) / 2
+	c = math.Cos(a.Parallel1)*math.Cos(a.Parallel1) + 2*n*math.Sin(a.Parallel1)
+	return n, c, math.Sqrt(c) / n
+}
+
+func (a Albers) Project(lat float64, lon float64) (float64, float64) {
+	n, c, rho0 := a.cone()
+	rho := math.Sqrt(c-2*n*math.Sin(lat)) / n
+	theta := n * lon
+	return rho * math.Sin(theta), rho0 - rho*math.Cos(theta)
+}
+
+func (a Albers) Inverse(x float64, y float64) (float64, float64) {
+	n, c, rho0 := a.cone()
+	sign := math.Copysign(1, n)
+	rho := math.Hypot(x, rho0-y)
+	theta := math.Atan2(sign*x, sign*(rho0-y))
+	return math.Asin(clampUnit((c - rho*rho*n*n) / (2 * n))), theta / n
+}
+
+// The bounding rectangle of the circular sector the sphere is mapped onto. The extremes lie at the poles, along
+// the central meridian, the antimeridian, or the meridians which the cone maps to horizontal lines.
+func (a Albers) PlanarBounds() Bounds {
+	n, _, _ := a.cone()
+	lons := []float64{0, -math.Pi, math.Pi}
+	if math.Abs(n) > 0.5 {
+		lons = append(lons, -math.Pi/2/math.Abs(n), math.Pi/2/math.Abs(n))
+	}
+	bounds := RectangleBounds{XMin: math.Inf(1), XMax: math.Inf(-1), YMin: math.Inf(1), YMax: math.Inf(-1)}
+	for _, lat := range []float64{-math.Pi / 2, math.Pi / 2} {
+		for _, lon := range lons {
+			x, y := a.Project(lat, lon)
+			bounds.XMin = math.Min(bounds.XMin, x)
+			bounds.XMax = math.Max(bounds.XMax, x)
+			bounds.YMin = math.Min(bounds.YMin, y)
+			bounds.YMax = math.Max(bounds.YMax, y)
+		}
+	}
+	return bounds
+}
+
+func (a Albers) Describe() Metadata {
+	return Metadata{
+		Name:       "Albers equal-area conic",
+		Aliases:    []string{"Albers"},
+		Family:     FamilyConic,
+		Properties: EqualArea,
+		Shape:      ShapeOther,
+		Extent:     ExtentRegional,
+		EPSGMethod: 9822,
+		PROJName:   "aea",
+	}
+}
+
+// A conformal conic projection with two standard parallels, commonly used for aeronautical charts and
+// mid-latitude regions which are wider east to west than north to south. Diverges toward the pole opposite
+// the standard parallels. The origin of the y coordinate is on the equator.
+// https://en.wikipedia.org/wiki/Lambert_conformal_conic_projection
+type LambertConformalConic struct {
+	// The first latitude (in radians) at which the scale is true.
+	Parallel1 float64
+	// The second latitude (in radians) at which the scale is true. The two parallels may be equal, but
+	// must not be placed symmetrically about the equator.
+	Parallel2 float64
+}
+
+func NewLambertConformalConic(parallel1 float64, parallel2 float64) LambertConformalConic {
+	return LambertConformalConic{Parallel1: parallel1, Parallel2: parallel2}
+}
+
+// The cone constant, the radius scaling constant, and the radius at the origin of the projection.
+func (l LambertConformalConic) cone() (n float64, f float64, rho0 float64) {
+	if l.Parallel1 == l.Parallel2 {
+		n = math.Sin(l.Parallel1)
+	} else {
+		n = math.Log(math.Cos(l.Parallel1)/math.Cos(l.Parallel2)) /
+			math.Log(math.Tan(math.Pi/4+l.Parallel2/2)/math.Tan(math.Pi/4+l.Parallel1/2))
+	}
+	f = math.Cos(l.Parallel1) * math.Pow(math.Tan(math.Pi/4+l.Parallel1/2), n) / n
+	return n, f, f
+}
+
+func (l LambertConformalConic) Project(lat float64, lon float64) (float64, float64) {
+	n, f, rho0 := l.cone()
+	rho := f / math.Pow(math.Tan(math.Pi/4+lat/2), n)
+	theta := n * lon
+	return rho * math.Sin(theta), rho0 - rho*math.Cos(theta)
+}
+
+func (l LambertConformalConic) Inverse(x float64, y float64) (float64, float64) {
+	n, f, rho0 := l.cone()
+	sign := math.Copysign(1, n)
+	rho := sign * math.Hypot(x, rho0-y)
+	theta := math.Atan2(sign*x, sign*(rho0-y))
+	if rho == 0 {
+		return sign * math.Pi / 2, theta / n
+	}
+	return 2*math.Atan(math.Pow(f/rho, 1/n)) - math.Pi/2, theta / n
+}
+
+func (l LambertConformalConic) PlanarBounds() Bounds {
+	return RectangleBounds{
+		XMin: math.Inf(-1),
+		YMin: math.Inf(-1),
+		XMax: math.Inf(1),
+		YMax: math.Inf(1),
+	}
+}
+
+func (l LambertConformalConic) Describe() Metadata {
+	return Metadata{
+		Name:       "Lambert conformal conic",
+		Family:     FamilyConic,
+		Properties: Conformal,
+		Shape:      ShapeUnbounded,
+		Extent:     ExtentRegional,
+		EPSGMethod: 9802,
+		PROJName:   "lcc",
+	}
+}
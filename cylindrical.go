@@ -6,29 +6,53 @@ import (
 
 // An early standard cylindrical projection useful for navigation.
 // https://en.wikipedia.org/wiki/Mercator_projection
-type Mercator struct{}
+type Mercator struct {
+	// The latitude (in radians) at which the scale is true (undistorted) for this projection.
+	Parallel float64
+}
 
 func NewMercator() Mercator {
 	return Mercator{}
 }
 
+// Construct a new Mercator projection with true scale along the given parallel (in radians), rather than the equator.
+func NewMercatorTrueScale(parallel float64) Mercator {
+	return Mercator{Parallel: parallel}
+}
+
 func (m Mercator) Project(lat float64, lon float64) (x float64, y float64) {
-	return lon, math.Log(math.Tan(math.Pi/4 + lat/2))
+	k := math.Cos(m.Parallel)
+	return k * lon, k * math.Log(math.Tan(math.Pi/4+lat/2))
 }
 
 func (m Mercator) Inverse(x float64, y float64) (lat float64, lon float64) {
-	return math.Atan(math.Sinh(y)), x
+	k := math.Cos(m.Parallel)
+	return math.Atan(math.Sinh(y / k)), x / k
 }
 
 func (m Mercator) PlanarBounds() Bounds {
+	k := math.Cos(m.Parallel)
 	return RectangleBounds{
-		XMin: -math.Pi,
+		XMin: -math.Pi * k,
 		YMin: math.Inf(-1),
-		XMax: math.Pi,
+		XMax: math.Pi * k,
 		YMax: math.Inf(1),
 	}
 }
 
+func (m Mercator) Describe() Metadata {
+	return Metadata{
+		Name:       "Mercator",
+		Aliases:    []string{"Wright"},
+		Family:     FamilyCylindrical,
+		Properties: Conformal | StraightRhumbLines,
+		Shape:      ShapeUnbounded,
+		Extent:     ExtentWorld,
+		EPSGMethod: 1026,
+		PROJName:   "merc",
+	}
+}
+
 // A special case of the equirectangular projection which allows for easy conversion between
 // pixel coordinates and locations on the sphere. The scale is less distorted the closer toward
 // the equator a spherical position is.
@@ -51,6 +75,19 @@ func (p PlateCarree) PlanarBounds() Bounds {
 	return NewRectangleBounds(2*math.Pi, math.Pi)
 }
 
+func (p PlateCarree) Describe() Metadata {
+	return Metadata{
+		Name:       "Plate carrée",
+		Aliases:    []string{"Equidistant cylindrical", "Geographic"},
+		Family:     FamilyCylindrical,
+		Properties: Equidistant,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentWorld,
+		EPSGMethod: 1029,
+		PROJName:   "eqc",
+	}
+}
+
 // A linear mapping of latitude and longitude to x and y, with the given latitude of focus
 // where results will be undistorted.
 // https://en.wikipedia.org/wiki/Equirectangular_projection
@@ -76,6 +113,19 @@ func (e Equirectangular) PlanarBounds() Bounds {
 	return NewRectangleBounds(2*math.Pi, math.Pi/math.Cos(e.Parallel))
 }
 
+func (e Equirectangular) Describe() Metadata {
+	return Metadata{
+		Name:       "Equirectangular",
+		Aliases:    []string{"Equidistant cylindrical"},
+		Family:     FamilyCylindrical,
+		Properties: Equidistant,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentWorld,
+		EPSGMethod: 1029,
+		PROJName:   "eqc",
+	}
+}
+
 // A generalized form of equal-area cylindrical projection.
 // https://en.wikipedia.org/wiki/Cylindrical_equal-area_projection
 type CylindricalEqualArea struct {
@@ -108,6 +158,18 @@ func (l CylindricalEqualArea) PlanarBounds() Bounds {
 	return NewRectangleBounds(2*math.Pi, 2/l.Stretch)
 }
 
+func (l CylindricalEqualArea) Describe() Metadata {
+	return Metadata{
+		Name:       "Cylindrical equal-area",
+		Family:     FamilyCylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentWorld,
+		EPSGMethod: 9834,
+		PROJName:   "cea",
+	}
+}
+
 // An equal area projection with least distortion near the equator.
 // https://en.wikipedia.org/wiki/Lambert_cylindrical_equal-area_projection
 type LambertCylindrical struct {
@@ -118,6 +180,19 @@ func NewLambertCylindrical() LambertCylindrical {
 	return LambertCylindrical{NewCylindricalEqualArea(0)}
 }
 
+func (l LambertCylindrical) Describe() Metadata {
+	return Metadata{
+		Name:       "Lambert cylindrical",
+		Aliases:    []string{"Lambert cylindrical equal-area"},
+		Family:     FamilyCylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentWorld,
+		EPSGMethod: 9834,
+		PROJName:   "cea",
+	}
+}
+
 // An equal area projection with least distortion at 30 degrees latitude.
 // https://en.wikipedia.org/wiki/Behrmann_projection
 type Behrmann struct {
@@ -128,6 +203,18 @@ func NewBehrmann() Behrmann {
 	return Behrmann{NewCylindricalEqualArea(math.Pi / 6)}
 }
 
+func (b Behrmann) Describe() Metadata {
+	return Metadata{
+		Name:       "Behrmann",
+		Family:     FamilyCylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentWorld,
+		EPSGMethod: 9834,
+		PROJName:   "cea",
+	}
+}
+
 // An equal area projection with least distortion at 45 degrees latitude.
 // https://en.wikipedia.org/wiki/Gall%E2%80%93Peters_projection
 type GallOrthographic struct {
@@ -138,6 +225,19 @@ func NewGallOrthographic() GallOrthographic {
 	return GallOrthographic{NewCylindricalEqualArea(45 * math.Pi / 180)}
 }
 
+func (g GallOrthographic) Describe() Metadata {
+	return Metadata{
+		Name:       "Gall orthographic",
+		Aliases:    []string{"Gall–Peters", "Peters"},
+		Family:     FamilyCylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentWorld,
+		EPSGMethod: 9834,
+		PROJName:   "cea",
+	}
+}
+
 // An equal area projection with least distortion at 37.5 degrees latitude.
 // https://en.wikipedia.org/wiki/Hobo%E2%80%93Dyer_projection
 type HoboDyer struct {
@@ -148,6 +248,18 @@ func NewHoboDyer() HoboDyer {
 	return HoboDyer{NewCylindricalEqualArea(37.5 * math.Pi / 180)}
 }
 
+func (h HoboDyer) Describe() Metadata {
+	return Metadata{
+		Name:       "Hobo–Dyer",
+		Family:     FamilyCylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentWorld,
+		EPSGMethod: 9834,
+		PROJName:   "cea",
+	}
+}
+
 // A compromise cylindrical projection that tries to minimize distortion as much as possible.
 // https://en.wikipedia.org/wiki/Gall_stereographic_projection
 type GallStereographic struct{}
@@ -173,24 +285,58 @@ func (g GallStereographic) PlanarBounds() Bounds {
 	}
 }
 
+func (g GallStereographic) Describe() Metadata {
+	return Metadata{
+		Name:     "Gall stereographic",
+		Aliases:  []string{"Gall"},
+		Family:   FamilyCylindrical,
+		Shape:    ShapeRectangle,
+		Extent:   ExtentWorld,
+		PROJName: "gall",
+	}
+}
+
 // A compromise cylindrical projection intended to resemble Mercator with less distortion at the poles.
 // https://en.wikipedia.org/wiki/Miller_cylindrical_projection
-type Miller struct{}
+type Miller struct {
+	// The latitude (in radians) at which the scale is true (undistorted) along the parallel.
+	Parallel float64
+}
 
 func NewMiller() Miller {
 	return Miller{}
 }
 
+// Construct a new Miller projection with true scale along the given parallel (in radians), rather than the equator.
+func NewMillerTrueScale(parallel float64) Miller {
+	return Miller{Parallel: parallel}
+}
+
 func (m Miller) Project(lat float64, lon float64) (x float64, y float64) {
-	return lon, math.Log(math.Tan(math.Pi/4+0.8*lat/2)) / 0.8
+	k := math.Cos(m.Parallel)
+	return k * lon, k * math.Log(math.Tan(math.Pi/4+0.8*lat/2)) / 0.8
 }
 
 func (m Miller) Inverse(x float64, y float64) (lat float64, lon float64) {
-	return math.Atan(math.Sinh(y*0.8)) / 0.8, x
+	k := math.Cos(m.Parallel)
+	return math.Atan(math.Sinh(y/k*0.8)) / 0.8, x / k
 }
 
 func (m Miller) PlanarBounds() Bounds {
-	return NewRectangleBounds(2*math.Pi, 2.5*math.Log(math.Tan(9*math.Pi/20)))
+	xMin, yMin := m.Project(-math.Pi/2, -math.Pi)
+	xMax, yMax := m.Project(math.Pi/2, math.Pi)
+	return RectangleBounds{XMin: xMin, XMax: xMax, YMin: yMin, YMax: yMax}
+}
+
+func (m Miller) Describe() Metadata {
+	return Metadata{
+		Name:     "Miller cylindrical",
+		Aliases:  []string{"Miller"},
+		Family:   FamilyCylindrical,
+		Shape:    ShapeRectangle,
+		Extent:   ExtentWorld,
+		PROJName: "mill",
+	}
 }
 
 // A compromise cylindrical projection with prominent use in panoramic photography, but very distorted
@@ -219,6 +365,16 @@ func (c Central) PlanarBounds() Bounds {
 	}
 }
 
+func (c Central) Describe() Metadata {
+	return Metadata{
+		Name:     "Central cylindrical",
+		Family:   FamilyCylindrical,
+		Shape:    ShapeUnbounded,
+		Extent:   ExtentRegional,
+		PROJName: "cc",
+	}
+}
+
 // A transverse version of the Plate–Carée projection, implemented directly for efficiency.
 // https://en.wikipedia.org/wiki/Cassini_projection
 type Cassini struct{}
@@ -242,3 +398,94 @@ func (c Cassini) Inverse(x float64, y float64) (float64, float64) {
 func (c Cassini) PlanarBounds() Bounds {
 	return NewRectangleBounds(math.Pi, 2*math.Pi)
 }
+
+func (c Cassini) Describe() Metadata {
+	return Metadata{
+		Name:       "Cassini",
+		Aliases:    []string{"Cassini–Soldner", "Transverse plate carrée"},
+		Family:     FamilyCylindrical,
+		Aspect:     AspectTransverse,
+		Properties: Equidistant,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentRegional,
+		EPSGMethod: 9806,
+		PROJName:   "cass",
+	}
+}
+
+// A transverse version of the Mercator projection, implemented directly for efficiency and precision, which is
+// conformal and has true scale (times the scale factor) along the central meridian. On an ellipsoid, this uses
+// the 6th order Krüger series, accurate to a few nanometers within 4000 kilometers of the central meridian on the
+// Earth, but increasingly inaccurate beyond that. Planar coordinates are in units of the ellipsoid's semi-major
+// axis, or of the unit sphere by default.
+// https://en.wikipedia.org/wiki/Transverse_Mercator_projection
+type TransverseMercator struct {
+	CentralMeridian  float64   // The longitude (in radians) of the central meridian.
+	LatitudeOfOrigin float64   // The latitude (in radians) on the central meridian placed at the origin.
+	ScaleFactor      float64   // The scale along the central meridian, such as 0.9996 for UTM.
+	Ellipsoid        Ellipsoid // The ellipsoid being projected, the unit sphere by default.
+
+	series  krugerSeries
+	originY float64
+}
+
+// Construct a transverse Mercator projection of the unit sphere.
+func NewTransverseMercator(centralMeridian float64, latitudeOfOrigin float64, scaleFactor float64) TransverseMercator {
+	return NewTransverseMercatorOnEllipsoid(centralMeridian, latitudeOfOrigin, scaleFactor, NewEllipsoid(1, 0))
+}
+
+// Construct a transverse Mercator projection of the given ellipsoid.
+func NewTransverseMercatorOnEllipsoid(centralMeridian float64, latitudeOfOrigin float64, scaleFactor float64, ellipsoid Ellipsoid) TransverseMercator {
+	series := newKrugerSeries(ellipsoid)
+	_, originY := series.forward(latitudeOfOrigin, 0)
+	return TransverseMercator{
+		CentralMeridian:  centralMeridian,
+		LatitudeOfOrigin: latitudeOfOrigin,
+		ScaleFactor:      scaleFactor,
+		Ellipsoid:        ellipsoid,
+		series:           series,
+		originY:          originY,
+	}
+}
+
+func (t TransverseMercator) Project(lat float64, lon float64) (float64, float64) {
+	shifted := lon - t.CentralMeridian
+	if math.Abs(shifted) > math.Pi {
+		shifted = coerceAngle(shifted)
+	}
+	x, y := t.series.forward(lat, shifted)
+	return t.ScaleFactor * x, t.ScaleFactor * (y - t.originY)
+}
+
+func (t TransverseMercator) Inverse(x float64, y float64) (float64, float64) {
+	lat, lon := t.series.reverse(x/t.ScaleFactor, y/t.ScaleFactor+t.originY)
+	lon += t.CentralMeridian
+	if math.Abs(lon) > math.Pi {
+		lon = coerceAngle(lon)
+	}
+	return lat, lon
+}
+
+func (t TransverseMercator) PlanarBounds() Bounds {
+	halfHeight := t.ScaleFactor * t.series.rectifyingRadius * math.Pi
+	return RectangleBounds{
+		XMin: math.Inf(-1),
+		YMin: -halfHeight - t.ScaleFactor*t.originY,
+		XMax: math.Inf(1),
+		YMax: halfHeight - t.ScaleFactor*t.originY,
+	}
+}
+
+func (t TransverseMercator) Describe() Metadata {
+	return Metadata{
+		Name:       "Transverse Mercator",
+		Aliases:    []string{"Gauss–Krüger"},
+		Family:     FamilyCylindrical,
+		Aspect:     AspectTransverse,
+		Properties: Conformal,
+		Shape:      ShapeUnbounded,
+		Extent:     ExtentRegional,
+		EPSGMethod: 9807,
+		PROJName:   "tmerc",
+	}
+}
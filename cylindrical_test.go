@@ -41,3 +41,49 @@ func FuzzEqualAreaCtorValidity(f *testing.F) {
 		}
 	})
 }
+
+func TestTransverseMercatorSphere(t *testing.T) {
+	proj := NewTransverseMercator(0.2, 0, 1)
+	for _, loc := range [][2]float64{{0, 0.2}, {0.5, 0.7}, {-1.2, -0.9}, {0.1, 2.5}} {
+		lat, lon := loc[0], loc[1]-0.2
+		expectedX := math.Atanh(math.Cos(lat) * math.Sin(lon))
+		expectedY := math.Atan2(math.Tan(lat), math.Cos(lon))
+		x, y := proj.Project(loc[0], loc[1])
+		if !withinTolerance(x, expectedX, 1e-12) || !withinTolerance(y, expectedY, 1e-12) {
+			t.Errorf("expected %e,%e, got %e,%e", expectedX, expectedY, x, y)
+		}
+	}
+
+	scaled := NewTransverseMercator(0, math.Pi/6, 2)
+	if x, y := scaled.Project(math.Pi/6, 0); !withinTolerance(x, 0, 1e-12) || !withinTolerance(y, 0, 1e-12) {
+		t.Errorf("expected latitude of origin at the origin, got %e,%e", x, y)
+	}
+	if _, y := scaled.Project(math.Pi/3, 0); !withinTolerance(y, math.Pi/3, 1e-12) {
+		t.Errorf("expected scaled meridian distance %e, got %e", math.Pi/3, y)
+	}
+}
+
+func TestTransverseMercatorEllipsoid(t *testing.T) {
+	geodesic := NewWGS84Geodesic()
+	proj := NewTransverseMercatorOnEllipsoid(radians(-2), radians(49), 0.9996012717, NewWGS84())
+	originDistance, _, _ := geodesic.Inverse(0, 0, radians(49), 0)
+	for _, lat := range []float64{-0.5, 0.3, 0.9, 1.5} {
+		distance, _, _ := geodesic.Inverse(0, 0, lat, 0)
+		if lat < 0 {
+			distance = -distance
+		}
+		x, y := proj.Project(lat, radians(-2))
+		if !withinTolerance(x, 0, 1e-9) || !withinTolerance(y, 0.9996012717*(distance-originDistance), 1e-6) {
+			t.Errorf("expected 0,%f at %f, got %f,%f", 0.9996012717*(distance-originDistance), lat, x, y)
+		}
+	}
+
+	// the forward and reverse series still agree thousands of kilometers from the central meridian
+	for _, loc := range [][2]float64{{radians(52), radians(30)}, {radians(-10), radians(-30)}, {radians(80), radians(60)}} {
+		x, y := proj.Project(loc[0], loc[1])
+		lat, lon := proj.Inverse(x, y)
+		if !withinTolerance(lat, loc[0], 1e-11) || !withinTolerance(lon, loc[1], 1e-11) {
+			t.Errorf("expected %e,%e, got %e,%e", loc[0], loc[1], lat, lon)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package flatsphere
+
+import (
+	"math"
+	"strings"
+)
+
+// A seven parameter Helmert transformation between the Earth-centered, Earth-fixed cartesian coordinates of
+// two datums, made up of a translation, a small rotation and a scale change, using the position vector
+// convention of EPSG method 9606 and PROJ's towgs84 parameter. Datums differ by at most a few hundred meters
+// and a few arcseconds, so the rotation is linearized.
+// https://en.wikipedia.org/wiki/Helmert_transformation
+type Helmert struct {
+	TX    float64 // The translation along the x axis, in meters.
+	TY    float64 // The translation along the y axis, in meters.
+	TZ    float64 // The translation along the z axis, in meters.
+	RX    float64 // The rotation (in radians) about the x axis.
+	RY    float64 // The rotation (in radians) about the y axis.
+	RZ    float64 // The rotation (in radians) about the z axis.
+	Scale float64 // The change in scale, in parts per million.
+}
+
+func NewHelmert(tx float64, ty float64, tz float64, rx float64, ry float64, rz float64, scale float64) Helmert {
+	return Helmert{TX: tx, TY: ty, TZ: tz, RX: rx, RY: ry, RZ: rz, Scale: scale}
+}
+
+// A Helmert transformation which only translates, as used by many older datum definitions.
+func NewHelmertTranslation(tx float64, ty float64, tz float64) Helmert {
+	return Helmert{TX: tx, TY: ty, TZ: tz}
+}
+
+// The scaled rotation matrix of the transformation.
+func (h Helmert) matrix() [3][3]float64 {
+	s := 1 + h.Scale*1e-6
+	return [3][3]float64{
+		{s, -s * h.RZ, s * h.RY},
+		{s * h.RZ, s, -s * h.RX},
+		{-s * h.RY, s * h.RX, s},
+	}
+}
+
+// Transform an Earth-centered, Earth-fixed position.
+func (h Helmert) Apply(v Vec3) Vec3 {
+	m := h.matrix()
+	return Vec3{
+		X: h.TX + m[0][0]*v.X + m[0][1]*v.Y + m[0][2]*v.Z,
+		Y: h.TY + m[1][0]*v.X + m[1][1]*v.Y + m[1][2]*v.Z,
+		Z: h.TZ + m[2][0]*v.X + m[2][1]*v.Y + m[2][2]*v.Z,
+	}
+}
+
+// Transform an Earth-centered, Earth-fixed position by the exact inverse of the transformation.
+func (h Helmert) ApplyInverse(v Vec3) Vec3 {
+	m := h.matrix()
+	x, y, z := v.X-h.TX, v.Y-h.TY, v.Z-h.TZ
+	// solve by Cramer's rule
+	det := m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+	return Vec3{
+		X: (x*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) - m[0][1]*(y*m[2][2]-m[1][2]*z) + m[0][2]*(y*m[2][1]-m[1][1]*z)) / det,
+		Y: (m[0][0]*(y*m[2][2]-m[1][2]*z) - x*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) + m[0][2]*(m[1][0]*z-y*m[2][0])) / det,
+		Z: (m[0][0]*(m[1][1]*z-y*m[2][1]) - m[0][1]*(m[1][0]*z-y*m[2][0]) + x*(m[1][0]*m[2][1]-m[1][1]*m[2][0])) / det,
+	}
+}
+
+// A geodetic datum, made up of the ellipsoid latitudes, longitudes and heights are measured on, and the
+// Helmert transformation from the datum's Earth-centered, Earth-fixed coordinates to those of WGS84.
+type Datum struct {
+	Name      string    // The common name of the datum.
+	Ellipsoid Ellipsoid // The ellipsoid of the datum.
+	ToWGS84   Helmert   // The transformation from the datum to WGS84.
+}
+
+func NewDatum(name string, ellipsoid Ellipsoid, toWGS84 Helmert) Datum {
+	return Datum{Name: name, Ellipsoid: ellipsoid, ToWGS84: toWGS84}
+}
+
+// The World Geodetic System 1984 datum used by GPS.
+func NewWGS84Datum() Datum {
+	return NewDatum("WGS84", NewWGS84(), Helmert{})
+}
+
+// The North American Datum of 1983, which agrees with WGS84 to within a couple of meters.
+func NewNAD83() Datum {
+	return NewDatum("NAD83", NewGRS80(), Helmert{})
+}
+
+// The North American Datum of 1927, with the mean shift to WGS84 over the contiguous United States
+// (EPSG transformation 1173), accurate to about ten meters.
+func NewNAD27() Datum {
+	return NewDatum("NAD27", NewClarke1866(), NewHelmertTranslation(-8, 160, 176))
+}
+
+// The European Datum of 1950, with the mean shift to WGS84 over western Europe (EPSG transformation 1133),
+// accurate to about ten meters.
+func NewED50() Datum {
+	return NewDatum("ED50", NewInternational1924(), NewHelmertTranslation(-87, -98, -121))
+}
+
+// The Ordnance Survey of Great Britain 1936 datum, with the shift to WGS84 published by the Ordnance Survey
+// (EPSG transformation 1314), accurate to a few meters.
+func NewOSGB36() Datum {
+	return NewDatum("OSGB36", NewAiry1830(), NewHelmert(446.448, -125.157, 542.06,
+		arcseconds(0.15), arcseconds(0.247), arcseconds(0.842), -20.489))
+}
+
+func arcseconds(seconds float64) float64 {
+	return radians(seconds / 3600)
+}
+
+// Find one of the built-in datums (WGS84, NAD83, NAD27, ED50 or OSGB36) by name, ignoring case.
+func DatumByName(name string) (Datum, bool) {
+	for _, datum := range []Datum{NewWGS84Datum(), NewNAD83(), NewNAD27(), NewED50(), NewOSGB36()} {
+		if strings.EqualFold(datum.Name, name) {
+			return datum, true
+		}
+	}
+	return Datum{}, false
+}
+
+// The method used to shift locations from one datum to another.
+type DatumMethod int
+
+const (
+	// Convert locations to Earth-centered, Earth-fixed coordinates and apply the full Helmert transformations
+	// of both datums through WGS84. Exact up to the accuracy of the datums' parameters.
+	DatumHelmert DatumMethod = iota
+	// Shift latitudes, longitudes and heights directly with the abridged Molodensky formulas, using only the
+	// translations of the datums. Faster, but ignores any rotation and scale, and adds errors of up to a few
+	// meters, growing toward the poles.
+	DatumMolodensky
+)
+
+// Shift a location (in radians) and height above the ellipsoid (in meters) from this datum onto another.
+func (d Datum) Transform(to Datum, method DatumMethod, lat float64, lon float64, height float64) (float64, float64, float64) {
+	if method == DatumMolodensky {
+		dx := d.ToWGS84.TX - to.ToWGS84.TX
+		dy := d.ToWGS84.TY - to.ToWGS84.TY
+		dz := d.ToWGS84.TZ - to.ToWGS84.TZ
+		return molodenskyShift(d.Ellipsoid, to.Ellipsoid, dx, dy, dz, lat, lon, height)
+	}
+	v := d.Ellipsoid.GeodeticToECEF(lat, lon, height)
+	return to.Ellipsoid.ECEFToGeodetic(to.ToWGS84.ApplyInverse(d.ToWGS84.Apply(v)))
+}
+
+// The abridged Molodensky formulas, shifting a location between ellipsoids whose centers differ by the given
+// translation. See DMA Technical Report 8350.2.
+func molodenskyShift(from Ellipsoid, to Ellipsoid, dx float64, dy float64, dz float64, lat float64, lon float64, height float64) (float64, float64, float64) {
+	a, f, e2 := from.SemiMajorAxis, from.Flattening, from.EccentricitySquared()
+	da, df := to.SemiMajorAxis-a, to.Flattening-f
+	sinLat, cosLat := math.Sincos(lat)
+	sinLon, cosLon := math.Sincos(lon)
+	w := math.Sqrt(1 - e2*sinLat*sinLat)
+	// the radii of curvature in the meridian and the prime vertical
+	m := a * (1 - e2) / (w * w * w)
+	n := a / w
+
+	flattening := a*df + f*da
+	dLat := (-dx*sinLat*cosLon - dy*sinLat*sinLon + dz*cosLat + flattening*2*sinLat*cosLat) / m
+	dLon := 0.0
+	if cosLat != 0 {
+		dLon = (-dx*sinLon + dy*cosLon) / (n * cosLat)
+	}
+	dHeight := dx*cosLat*cosLon + dy*cosLat*sinLon + dz*sinLat + flattening*sinLat*sinLat - da
+	return lat + dLat, coerceAngle(lon + dLon), height + dHeight
+}
+
+// A projection of locations given in one datum, which are shifted onto the datum the original projection
+// expects before being projected. Inverted locations are shifted back, so that reprojecting data between
+// datums and projections is a single Inverse and Project. Heights are taken to be zero, so a round trip through
+// Project and Inverse moves locations by well under a centimeter.
+type DatumShift struct {
+	orig   Projection
+	From   Datum       // The datum of the locations given to Project and returned by Inverse.
+	To     Datum       // The datum expected by the original projection.
+	Method DatumMethod // The method used to shift between the datums.
+}
+
+func NewDatumShift(original Projection, from Datum, to Datum, method DatumMethod) DatumShift {
+	return DatumShift{original, from, to, method}
+}
+
+func (d DatumShift) Project(latitude float64, longitude float64) (float64, float64) {
+	lat, lon, _ := d.From.Transform(d.To, d.Method, latitude, longitude, 0)
+	return d.orig.Project(lat, lon)
+}
+
+func (d DatumShift) Inverse(x float64, y float64) (float64, float64) {
+	lat, lon := d.orig.Inverse(x, y)
+	lat, lon, _ = d.To.Transform(d.From, d.Method, lat, lon, 0)
+	return lat, lon
+}
+
+func (d DatumShift) PlanarBounds() Bounds {
+	return d.orig.PlanarBounds()
+}
+
+func (d DatumShift) Describe() Metadata {
+	if describer, ok := d.orig.(Describer); ok {
+		return describer.Describe()
+	}
+	return Metadata{Name: "Datum shifted projection"}
+}
@@ -0,0 +1,103 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHelmertApplyInverse(t *testing.T) {
+	for _, h := range []Helmert{NewOSGB36().ToWGS84, NewHelmert(-100, 50, 300, 0.00001, -0.00002, 0.00003, 12)} {
+		for _, v := range []Vec3{{6378137, 0, 0}, {3980581, -111, 4966825}, {-2000000, 4000000, -4500000}} {
+			moved := h.Apply(v)
+			back := h.ApplyInverse(moved)
+			if !withinTolerance(back.X, v.X, 1e-6) || !withinTolerance(back.Y, v.Y, 1e-6) || !withinTolerance(back.Z, v.Z, 1e-6) {
+				t.Errorf("expected %v, got %v", v, back)
+			}
+		}
+	}
+
+	moved := NewHelmertTranslation(1, 2, 3).Apply(Vec3{10, 20, 30})
+	if moved != (Vec3{11, 22, 33}) {
+		t.Errorf("expected translation, got %v", moved)
+	}
+	scaled := NewHelmert(0, 0, 0, 0, 0, 0, 10).Apply(Vec3{1000000, 0, 0})
+	if !withinTolerance(scaled.X, 1000010, 1e-9) {
+		t.Errorf("expected scale of 10 parts per million, got %v", scaled)
+	}
+}
+
+func TestDatumTransform(t *testing.T) {
+	lat, lon := 51.5*math.Pi/180, -0.1*math.Pi/180
+	geodesic := NewWGS84Geodesic()
+	testCases := []struct {
+		name    string
+		from    Datum
+		minimum float64
+		maximum float64
+	}{
+		{"WGS84", NewWGS84Datum(), 0, 0.000001},
+		{"NAD83", NewNAD83(), 0, 0.001},
+		{"OSGB36", NewOSGB36(), 50, 150},
+		{"ED50", NewED50(), 50, 150},
+		{"NAD27", NewNAD27(), 50, 250},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, method := range []DatumMethod{DatumHelmert, DatumMolodensky} {
+				shiftedLat, shiftedLon, _ := tc.from.Transform(NewWGS84Datum(), method, lat, lon, 0)
+				shift, _, _ := geodesic.Inverse(lat, lon, shiftedLat, shiftedLon)
+				if shift < tc.minimum || shift > tc.maximum {
+					t.Errorf("expected a shift between %f and %f meters, got %f", tc.minimum, tc.maximum, shift)
+				}
+				backLat, backLon, _ := NewWGS84Datum().Transform(tc.from, method, shiftedLat, shiftedLon, 0)
+				if !withinTolerance(backLat, lat, 1e-8) || !withinTolerance(backLon, lon, 1e-8) {
+					t.Errorf("expected %e,%e, got %e,%e", lat, lon, backLat, backLon)
+				}
+			}
+		})
+	}
+}
+
+func TestDatumMolodenskyAgreesWithHelmert(t *testing.T) {
+	ed50, wgs84 := NewED50(), NewWGS84Datum()
+	for _, loc := range [][2]float64{{40, -3}, {55, 12}, {35, 25}, {60, 5}} {
+		lat, lon := loc[0]*math.Pi/180, loc[1]*math.Pi/180
+		helmertLat, helmertLon, helmertHeight := ed50.Transform(wgs84, DatumHelmert, lat, lon, 100)
+		molodenskyLat, molodenskyLon, molodenskyHeight := ed50.Transform(wgs84, DatumMolodensky, lat, lon, 100)
+		distance, _, _ := NewWGS84Geodesic().Inverse(helmertLat, helmertLon, molodenskyLat, molodenskyLon)
+		if distance > 1 || !withinTolerance(helmertHeight, molodenskyHeight, 1) {
+			t.Errorf("expected methods to agree within a meter at %v, got %f horizontally and %f vertically", loc, distance, helmertHeight-molodenskyHeight)
+		}
+	}
+}
+
+func TestDatumByName(t *testing.T) {
+	for _, name := range []string{"WGS84", "nad83", "NAD27", "ed50", "OSGB36"} {
+		if _, ok := DatumByName(name); !ok {
+			t.Errorf("expected to find datum %s", name)
+		}
+	}
+	if _, ok := DatumByName("Tokyo"); ok {
+		t.Errorf("expected not to find datum Tokyo")
+	}
+}
+
+func TestDatumShiftProjection(t *testing.T) {
+	utm := NewUTM(30, false)
+	proj := NewDatumShift(utm, NewOSGB36(), NewWGS84Datum(), DatumHelmert)
+	lat, lon := 51.5*math.Pi/180, -0.1*math.Pi/180
+
+	x, y := proj.Project(lat, lon)
+	shiftedLat, shiftedLon, _ := NewOSGB36().Transform(NewWGS84Datum(), DatumHelmert, lat, lon, 0)
+	expectedX, expectedY := utm.Project(shiftedLat, shiftedLon)
+	if !withinTolerance(x, expectedX, 1e-6) || !withinTolerance(y, expectedY, 1e-6) {
+		t.Errorf("expected %f,%f, got %f,%f", expectedX, expectedY, x, y)
+	}
+
+	// heights are dropped on the way in and out, which moves the location by well under a centimeter
+	invLat, invLon := proj.Inverse(x, y)
+	if !withinTolerance(invLat, lat, 1e-9) || !withinTolerance(invLon, lon, 1e-9) {
+		t.Errorf("expected %e,%e, got %e,%e", lat, lon, invLat, invLon)
+	}
+}
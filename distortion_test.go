@@ -1,6 +1,9 @@
 package flatsphere
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func Test_DistortionNull(t *testing.T) {
 	proj := NewMercator()
@@ -20,3 +23,39 @@ func Test_DistortionNull(t *testing.T) {
 		t.Errorf("expected 0 angular distortion at mercator equator, got %f", eqAngular)
 	}
 }
+
+func TestTrueScaleParallels(t *testing.T) {
+	parallel := 50 * math.Pi / 180
+	testCases := []struct {
+		name string
+		proj Projection
+		lat  float64
+	}{
+		{"Mercator", NewMercatorTrueScale(parallel), parallel},
+		{"PolarStereographic", NewPolarStereographic(parallel), parallel},
+		{"SouthPolarStereographic", NewPolarStereographic(-parallel), -parallel},
+		{"PolarStereographicScale", NewPolarStereographicScale(NewPolarStereographic(parallel).ScaleFactor(), false), parallel},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			area := AreaDistortionAt(tc.proj, tc.lat, 0.3)
+			if !withinTolerance(area, 0, 0.00001) {
+				t.Errorf("expected no area distortion at the true scale parallel, got %e", area)
+			}
+		})
+	}
+}
+
+func TestMeridianShift(t *testing.T) {
+	shifted := NewMeridianShift(NewSinusoidal(), math.Pi/2)
+	x, y := shifted.Project(0.3, math.Pi/2)
+	if !withinTolerance(x, 0, 0.000001) || !withinTolerance(y, 0.3, 0.000001) {
+		t.Errorf("expected central meridian to project to the center line, got %e,%e", x, y)
+	}
+	// locations just across the antimeridian of the shift wrap to the opposite edge
+	x, _ = shifted.Project(0, -math.Pi/2-0.1)
+	if !withinTolerance(x, math.Pi-0.1, 0.000001) {
+		t.Errorf("expected wrapped longitude near the east edge, got %e", x)
+	}
+}
@@ -0,0 +1,183 @@
+package flatsphere
+
+import "math"
+
+// An oblate ellipsoid of revolution approximating the shape of a planet, described by its equatorial
+// radius and flattening. Units of the semi-major axis determine the units of any distances or
+// areas computed on the ellipsoid.
+type Ellipsoid struct {
+	SemiMajorAxis float64 // The equatorial radius of the ellipsoid.
+	Flattening    float64 // The flattening (a - b) / a of the ellipsoid, zero for a sphere.
+}
+
+// Construct an ellipsoid from its equatorial radius and flattening.
+func NewEllipsoid(semiMajorAxis float64, flattening float64) Ellipsoid {
+	return Ellipsoid{SemiMajorAxis: semiMajorAxis, Flattening: flattening}
+}
+
+// The World Geodetic System 1984 ellipsoid used by GPS, in meters.
+// https://en.wikipedia.org/wiki/World_Geodetic_System
+func NewWGS84() Ellipsoid {
+	return NewEllipsoid(6378137, 1/298.257223563)
+}
+
+// The Geodetic Reference System 1980 ellipsoid used by NAD83 and ETRS89, in meters, which differs from
+// WGS84 by a fraction of a millimeter.
+func NewGRS80() Ellipsoid {
+	return NewEllipsoid(6378137, 1/298.257222101)
+}
+
+// The Clarke 1866 ellipsoid used by NAD27, in meters.
+func NewClarke1866() Ellipsoid {
+	return NewEllipsoid(6378206.4, 1/294.978698214)
+}
+
+// The International 1924 (Hayford) ellipsoid used by ED50, in meters.
+func NewInternational1924() Ellipsoid {
+	return NewEllipsoid(6378388, 1/297.0)
+}
+
+// The Airy 1830 ellipsoid used by OSGB36 in Great Britain, in meters.
+func NewAiry1830() Ellipsoid {
+	return NewEllipsoid(6377563.396, 1/299.3249646)
+}
+
+// The polar radius of the ellipsoid.
+func (e Ellipsoid) SemiMinorAxis() float64 {
+	return e.SemiMajorAxis * (1 - e.Flattening)
+}
+
+// The square of the first eccentricity of the ellipsoid.
+func (e Ellipsoid) EccentricitySquared() float64 {
+	return e.Flattening * (2 - e.Flattening)
+}
+
+// The square of the second eccentricity of the ellipsoid.
+func (e Ellipsoid) SecondEccentricitySquared() float64 {
+	return e.EccentricitySquared() / ((1 - e.Flattening) * (1 - e.Flattening))
+}
+
+// The third flattening (a - b) / (a + b) of the ellipsoid.
+func (e Ellipsoid) ThirdFlattening() float64 {
+	return e.Flattening / (2 - e.Flattening)
+}
+
+// The radius of a sphere with the same surface area as the ellipsoid.
+func (e Ellipsoid) AuthalicRadius() float64 {
+	e2 := e.EccentricitySquared()
+	b := e.SemiMinorAxis()
+	var ratio float64
+	switch {
+	case e2 == 0:
+		ratio = 1
+	case e2 > 0:
+		ratio = math.Atanh(math.Sqrt(e2)) / math.Sqrt(e2)
+	default:
+		ratio = math.Atan(math.Sqrt(-e2)) / math.Sqrt(-e2)
+	}
+	return math.Sqrt((e.SemiMajorAxis*e.SemiMajorAxis + b*b*ratio) / 2)
+}
+
+// The tangent of the conformal latitude corresponding to the tangent of a geodetic latitude, following
+// Karney (2011), "Transverse Mercator with an accuracy of a few nanometers".
+func (e Ellipsoid) conformalTan(tau float64) float64 {
+	es := math.Sqrt(math.Abs(e.EccentricitySquared()))
+	tau1 := math.Hypot(1, tau)
+	var sig float64
+	if e.EccentricitySquared() >= 0 {
+		sig = math.Sinh(es * math.Atanh(es*tau/tau1))
+	} else {
+		sig = math.Sinh(-es * math.Atan(es*tau/tau1))
+	}
+	return math.Hypot(1, sig)*tau - sig*tau1
+}
+
+// The tangent of the geodetic latitude corresponding to the tangent of a conformal latitude, the reverse of
+// conformalTan, found by Newton's method.
+func (e Ellipsoid) geodeticTan(taup float64) float64 {
+	if math.IsInf(taup, 0) {
+		return taup
+	}
+	e2m := 1 - e.EccentricitySquared()
+	tau := taup / e2m
+	for i := 0; i < 10; i++ {
+		taupa := e.conformalTan(tau)
+		dtau := (taup - taupa) * (1 + e2m*tau*tau) / (e2m * math.Hypot(1, tau) * math.Hypot(1, taupa))
+		tau += dtau
+		if math.Abs(dtau) < 1e-14*math.Max(1, math.Abs(tau)) {
+			break
+		}
+	}
+	return tau
+}
+
+// The coefficients of the 6th order Krüger series for the transverse Mercator projection of an ellipsoid,
+// accurate to a few nanometers within 4000 kilometers of the central meridian on the Earth.
+// See Karney (2011), "Transverse Mercator with an accuracy of a few nanometers".
+type krugerSeries struct {
+	ellipsoid        Ellipsoid
+	rectifyingRadius float64
+	alpha            [6]float64
+	beta             [6]float64
+}
+
+func newKrugerSeries(ellipsoid Ellipsoid) krugerSeries {
+	n := ellipsoid.ThirdFlattening()
+	n2 := n * n
+	n3 := n2 * n
+	n4 := n3 * n
+	n5 := n4 * n
+	n6 := n5 * n
+	return krugerSeries{
+		ellipsoid:        ellipsoid,
+		rectifyingRadius: ellipsoid.SemiMajorAxis / (1 + n) * (1 + n2/4 + n4/64 + n6/256),
+		alpha: [6]float64{
+			n/2 - 2*n2/3 + 5*n3/16 + 41*n4/180 - 127*n5/288 + 7891*n6/37800,
+			13*n2/48 - 3*n3/5 + 557*n4/1440 + 281*n5/630 - 1983433*n6/1935360,
+			61*n3/240 - 103*n4/140 + 15061*n5/26880 + 167603*n6/181440,
+			49561*n4/161280 - 179*n5/168 + 6601661*n6/7257600,
+			34729*n5/80640 - 3418889*n6/1995840,
+			212378941 * n6 / 319334400,
+		},
+		beta: [6]float64{
+			n/2 - 2*n2/3 + 37*n3/96 - n4/360 - 81*n5/512 + 96199*n6/604800,
+			n2/48 + n3/15 - 437*n4/1440 + 46*n5/105 - 1118711*n6/3870720,
+			17*n3/480 - 37*n4/840 - 209*n5/4480 + 5569*n6/90720,
+			4397*n4/161280 - 11*n5/504 - 830251*n6/7257600,
+			4583*n5/161280 - 108847*n6/3991680,
+			20648693 * n6 / 638668800,
+		},
+	}
+}
+
+// Project a location (in radians) relative to the central meridian, giving coordinates in the units of the
+// ellipsoid for a unit scale factor on the central meridian, measured from the equator.
+func (k krugerSeries) forward(lat float64, lon float64) (float64, float64) {
+	taup := k.ellipsoid.conformalTan(math.Tan(lat))
+	sinLon, cosLon := math.Sincos(lon)
+	xip := math.Atan2(taup, cosLon)
+	etap := math.Asinh(sinLon / math.Hypot(taup, cosLon))
+
+	xi, eta := xip, etap
+	for j, a := range k.alpha {
+		jj := 2 * float64(j+1)
+		xi += a * math.Sin(jj*xip) * math.Cosh(jj*etap)
+		eta += a * math.Cos(jj*xip) * math.Sinh(jj*etap)
+	}
+	return k.rectifyingRadius * eta, k.rectifyingRadius * xi
+}
+
+// The reverse of forward, returning a location (in radians) relative to the central meridian.
+func (k krugerSeries) reverse(x float64, y float64) (float64, float64) {
+	xi, eta := y/k.rectifyingRadius, x/k.rectifyingRadius
+	xip, etap := xi, eta
+	for j, b := range k.beta {
+		jj := 2 * float64(j+1)
+		xip -= b * math.Sin(jj*xi) * math.Cosh(jj*eta)
+		etap -= b * math.Cos(jj*xi) * math.Sinh(jj*eta)
+	}
+	sinXip, cosXip := math.Sincos(xip)
+	sinhEtap := math.Sinh(etap)
+	taup := sinXip / math.Hypot(sinhEtap, cosXip)
+	return math.Atan(k.ellipsoid.geodeticTan(taup)), math.Atan2(sinhEtap, cosXip)
+}
@@ -0,0 +1,164 @@
+package flatsphere
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Carlson's symmetric elliptic integral of the first kind RF(x, y, z), computed by the duplication theorem for
+// complex arguments off the negative real axis. Every other elliptic integral needed here is a special case of it.
+// See Carlson (1995), "Numerical computation of real or complex elliptic integrals".
+func carlsonRF(x complex128, y complex128, z complex128) complex128 {
+	for i := 0; i < 100; i++ {
+		a := (x + y + z) / 3
+		dx, dy, dz := 1-x/a, 1-y/a, 1-z/a
+		if max(cmplx.Abs(dx), cmplx.Abs(dy), cmplx.Abs(dz)) < 1e-3 {
+			e2 := dx*dy - dz*dz
+			e3 := dx * dy * dz
+			return (1 - e2/10 + e3/14 + e2*e2/24 - 3*e2*e3/44) / cmplx.Sqrt(a)
+		}
+		sx, sy, sz := cmplx.Sqrt(x), cmplx.Sqrt(y), cmplx.Sqrt(z)
+		lambda := sx*sy + sy*sz + sz*sx
+		x, y, z = (x+lambda)/4, (y+lambda)/4, (z+lambda)/4
+	}
+	return cmplx.NaN()
+}
+
+// The complete elliptic integral of the first kind K(m), for the parameter m = k² less than one.
+func ellipticK(m float64) float64 {
+	return real(carlsonRF(0, complex(1-m, 0), 1))
+}
+
+// The incomplete elliptic integral of the first kind F(φ|m), for the parameter m = k² at most one.
+func ellipticF(phi float64, m float64) float64 {
+	// F is quasi-periodic, gaining 2K for each half turn of the amplitude
+	turns := math.Round(phi / math.Pi)
+	phi -= turns * math.Pi
+	sinPhi, cosPhi := math.Sincos(phi)
+	f := sinPhi * real(carlsonRF(complex(cosPhi*cosPhi, 0), complex(1-m*sinPhi*sinPhi, 0), 1))
+	if turns != 0 {
+		f += 2 * turns * ellipticK(m)
+	}
+	return f
+}
+
+// The Jacobi elliptic functions sn, cn and dn of a real argument, for the parameter m = k² between zero and
+// one, by the descending Landen transformation (the arithmetic-geometric mean).
+// See Abramowitz and Stegun, "Handbook of Mathematical Functions", 16.4.
+func jacobiElliptic(u float64, m float64) (sn float64, cn float64, dn float64) {
+	if m < 1e-15 {
+		sn, cn = math.Sincos(u)
+		return sn, cn, 1
+	}
+	if m > 1-1e-15 {
+		sech := 1 / math.Cosh(u)
+		return math.Tanh(u), sech, sech
+	}
+
+	var a, c [16]float64
+	a[0], c[0] = 1, math.Sqrt(m)
+	b := math.Sqrt(1 - m)
+	n := 0
+	for n < len(a)-1 && math.Abs(c[n]) > 1e-16 {
+		a[n+1], c[n+1] = (a[n]+b)/2, (a[n]-b)/2
+		b = math.Sqrt(a[n] * b)
+		n++
+	}
+	phi := math.Ldexp(a[n]*u, n)
+	for ; n > 0; n-- {
+		phi = (phi + math.Asin(c[n]*math.Sin(phi)/a[n])) / 2
+	}
+	sn, cn = math.Sincos(phi)
+	// dn is positive for real arguments, and this avoids the quotient of cosines vanishing at the quarter period
+	return sn, cn, math.Sqrt(1 - m*sn*sn)
+}
+
+// The Jacobi elliptic functions sn, cn and dn of a complex argument, for the parameter m = k² between zero
+// and one, from those of its real and imaginary parts by the addition theorems and Jacobi's imaginary
+// transformation. See Abramowitz and Stegun, "Handbook of Mathematical Functions", 16.21.
+func jacobiEllipticComplex(u complex128, m float64) (sn complex128, cn complex128, dn complex128) {
+	s, c, d := jacobiElliptic(real(u), m)
+	s1, c1, d1 := jacobiElliptic(imag(u), 1-m)
+	delta := c1*c1 + m*s*s*s1*s1
+	sn = complex(s*d1, c*d*s1*c1) / complex(delta, 0)
+	cn = complex(c*c1, -s*d*s1*d1) / complex(delta, 0)
+	dn = complex(d*c1*d1, -m*s*c*s1) / complex(delta, 0)
+	return sn, cn, dn
+}
+
+// The conformal map of the unit disk onto a square, the integral of 1/√(1 - t⁴) from zero to w, sending
+// the points ±1 and ±i of the circle to the corners of the square at ±K/√2 and ±iK/√2 (where K = K(1/2))
+// and keeping the scale at the center. The square of a hemisphere in the quincuncial projections.
+func diskToSquare(w complex128) complex128 {
+	w2 := w * w
+	return w * carlsonRF(1-w2, 1+w2, 1)
+}
+
+// The reverse of diskToSquare. Since F(arccos w | 1/2) = √2 (K - diskToSquare(w)), the disk point is an
+// elliptic cosine, w = cn(K - √2 z | 1/2).
+func squareToDisk(z complex128) complex128 {
+	_, cn, _ := jacobiEllipticComplex(complex(ellipticK(0.5), 0)-complex(math.Sqrt2, 0)*z, 0.5)
+	return cn
+}
+
+// The integral of 1/√(1 - t³) from zero to u, an elliptic integral of the equianharmonic case, by Carlson's
+// reduction of integrals of the reciprocal square root of a cubic to RF. It maps the plane, cut along the rays
+// from the cube roots of unity outward, conformally onto an equilateral triangle centered on the origin, with
+// the middles of its edges at the images of the cube roots of unity, and is the flat side of the conformal
+// maps of triangular faces. The cube roots of unity are square root branch points, near which the integral is
+// only as accurate as 1 - u³, so that is given as w by callers which know it better than u does.
+// See Carlson (1988), "A table of elliptic integrals of the third kind".
+func triangleIntegral(u complex128, w complex128) complex128 {
+	if cmplx.Abs(u) < 1e-8 {
+		// the leading terms of the series u + u⁴/8, avoiding the cancellation below
+		return u + u*u*u*u/8
+	}
+	// the integral turns with u by thirds of a turn, and the reduction only keeps to the right branch of RF in
+	// the third of the plane around the negative real axis, between the cuts, so turn u into it, taking the side
+	// of the cut along the positive real axis from the sign of the imaginary part, even when it is zero
+	turn := complex(1, 0)
+	if phase := cmplx.Phase(u); math.Abs(phase) < 2*math.Pi/3 {
+		turn = cmplx.Rect(1, math.Copysign(2*math.Pi/3, -phase))
+	}
+	u /= turn
+	// the factors 1 - u ω⁻ᵏ of 1 - u³, the nearest to zero taken from w rather than from u, and those for the
+	// cube roots of unity on either side of the third of the plane kept to its sides of their cuts
+	factors := [3]complex128{1 - u, 1 - u*complex(-0.5, -math.Sqrt(3)/2), 1 - u*complex(-0.5, math.Sqrt(3)/2)}
+	nearest := 1
+	if cmplx.Abs(factors[2]) < cmplx.Abs(factors[1]) {
+		nearest = 2
+	}
+	factors[nearest] = w / (factors[0] * factors[3-nearest])
+	factors[1] = complex(real(factors[1]), -math.Abs(imag(factors[1])))
+	factors[2] = complex(real(factors[2]), math.Abs(imag(factors[2])))
+	var x [3]complex128
+	for k := range x {
+		x[k] = cmplx.Sqrt(factors[k])
+	}
+	u1 := (x[0] + x[1]*x[2]) / u
+	u2 := (x[1] + x[0]*x[2]) / u
+	u3 := (x[2] + x[0]*x[1]) / u
+	t := 2 * carlsonRF(u1*u1, u2*u2, u3*u3)
+	// RF only sees the squares, so fix the sign, knowing the integral turns less than a right angle from u
+	if real(t*cmplx.Conj(u)) < 0 {
+		t = -t
+	}
+	return t * turn
+}
+
+// The derivative of triangleIntegral, 1/√(1 - u³), on the same branch.
+func triangleIntegralDerivative(u complex128) complex128 {
+	d := complex(1, 0)
+	for k := 0; k < 3; k++ {
+		w := u * cmplx.Rect(1, -2*math.Pi*float64(k)/3)
+		// negating the imaginary part rather than subtracting keeps the side of the cut for a zero
+		d /= cmplx.Sqrt(complex(1-real(w), -imag(w)))
+	}
+	return d
+}
+
+// The distance from the center to the corners of the triangle of triangleIntegral, the integral along the
+// negative real axis to infinity, B(1/3, 1/6) / 3.
+func triangleIntegralCorner() float64 {
+	return math.Gamma(1.0/3) * math.Gamma(1.0/6) / math.Gamma(0.5) / 3
+}
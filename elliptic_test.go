@@ -0,0 +1,134 @@
+package flatsphere
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// Values from Abramowitz and Stegun, "Handbook of Mathematical Functions", tables 17.1 and 17.5.
+func TestEllipticIntegrals(t *testing.T) {
+	testCases := []struct {
+		name     string
+		got      float64
+		expected float64
+	}{
+		{"K(0)", ellipticK(0), math.Pi / 2},
+		{"K(0.5)", ellipticK(0.5), 1.854074677301372},
+		{"K(0.9)", ellipticK(0.9), 2.578092113348173},
+		{"F(45°|0.5)", ellipticF(math.Pi/4, 0.5), 0.826017876249245},
+		{"F(30°|0.25)", ellipticF(math.Pi/6, 0.25), 0.529428627051906},
+		{"F(90°|0.5)", ellipticF(math.Pi/2, 0.5), 1.854074677301372},
+		{"F(-45°|0.5)", ellipticF(-math.Pi/4, 0.5), -0.826017876249245},
+		{"F(180°|0.5)", ellipticF(math.Pi, 0.5), 2 * 1.854074677301372},
+		{"F(1|1)", ellipticF(1, 1), math.Atanh(math.Sin(1))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !withinTolerance(tc.got, tc.expected, 1e-14) {
+				t.Errorf("expected %.15f, got %.15f", tc.expected, tc.got)
+			}
+		})
+	}
+}
+
+func TestJacobiElliptic(t *testing.T) {
+	testCases := []struct {
+		u, m       float64
+		sn, cn, dn float64
+	}{
+		{0.5, 0.3, 0.474215622711821, 0.880408736426462, 0.965678964745951},
+		{0.8, 0, math.Sin(0.8), math.Cos(0.8), 1},
+		{0.8, 1, math.Tanh(0.8), 1 / math.Cosh(0.8), 1 / math.Cosh(0.8)},
+		{ellipticK(0.5), 0.5, 1, 0, math.Sqrt(0.5)},
+		{-ellipticK(0.7), 0.7, -1, 0, math.Sqrt(0.3)},
+	}
+
+	for _, tc := range testCases {
+		sn, cn, dn := jacobiElliptic(tc.u, tc.m)
+		if !withinTolerance(sn, tc.sn, 1e-14) || !withinTolerance(cn, tc.cn, 1e-14) || !withinTolerance(dn, tc.dn, 1e-14) {
+			t.Errorf("expected sn, cn, dn of %f|%f to be %f, %f, %f, got %f, %f, %f", tc.u, tc.m, tc.sn, tc.cn, tc.dn, sn, cn, dn)
+		}
+		if f := ellipticF(math.Asin(sn), tc.m); tc.m < 1 && math.Abs(tc.u) < ellipticK(tc.m)-1e-3 && !withinTolerance(f, tc.u, 1e-13) {
+			t.Errorf("expected sn to invert F at %f|%f, got %f", tc.u, tc.m, f)
+		}
+	}
+}
+
+func TestJacobiEllipticComplex(t *testing.T) {
+	m := 0.3
+	k, kp := ellipticK(m), ellipticK(1-m)
+
+	// sn(K + iK') = 1/k
+	sn, _, _ := jacobiEllipticComplex(complex(k, kp), m)
+	if cmplx.Abs(sn-complex(1/math.Sqrt(m), 0)) > 1e-13 {
+		t.Errorf("expected sn(K + iK') to be %f, got %v", 1/math.Sqrt(m), sn)
+	}
+
+	// Jacobi's imaginary transformation, sn(iu|m) = i sc(u|1 - m)
+	s1, c1, _ := jacobiElliptic(0.7, 1-m)
+	sn, cn, dn := jacobiEllipticComplex(complex(0, 0.7), m)
+	if cmplx.Abs(sn-complex(0, s1/c1)) > 1e-14 || cmplx.Abs(cn-complex(1/c1, 0)) > 1e-14 {
+		t.Errorf("expected sn, cn of 0.7i to be %f i, %f, got %v, %v", s1/c1, 1/c1, sn, cn)
+	}
+
+	// the identities sn² + cn² = 1 and dn² + m sn² = 1 hold off the real line
+	sn, cn, dn = jacobiEllipticComplex(complex(0.9, -1.3), m)
+	if cmplx.Abs(sn*sn+cn*cn-1) > 1e-14 || cmplx.Abs(dn*dn+complex(m, 0)*sn*sn-1) > 1e-14 {
+		t.Errorf("expected Jacobi identities to hold, got %v, %v", sn*sn+cn*cn, dn*dn+complex(m, 0)*sn*sn)
+	}
+}
+
+func TestDiskToSquare(t *testing.T) {
+	// half the lemniscate constant
+	halfDiagonal := 1.311028777146060
+	for _, w := range []complex128{1, 1i, -1, -1i} {
+		if z := diskToSquare(w); cmplx.Abs(z-w*complex(halfDiagonal, 0)) > 1e-14 {
+			t.Errorf("expected %v to map to the corner %v, got %v", w, w*complex(halfDiagonal, 0), z)
+		}
+	}
+	// the middle of an edge
+	if z := diskToSquare(cmplx.Rect(1, math.Pi/4)); !withinTolerance(real(z)+imag(z), halfDiagonal, 1e-14) || !withinTolerance(real(z), imag(z), 1e-14) {
+		t.Errorf("expected the unit circle at 45 degrees to map to the middle of an edge, got %v", z)
+	}
+	for _, w := range []complex128{0, 0.3 + 0.4i, -0.7 + 0.6i, 0.99i, -0.2 - 0.1i} {
+		if back := squareToDisk(diskToSquare(w)); cmplx.Abs(back-w) > 1e-14 {
+			t.Errorf("expected %v to round trip, got %v", w, back)
+		}
+	}
+}
+
+func TestTriangleIntegral(t *testing.T) {
+	// the middles of the edges, at the inradius of the triangle, B(1/3, 1/2) / 3
+	inradius := math.Gamma(1.0/3) * math.Gamma(0.5) / math.Gamma(5.0/6) / 3
+	if !withinTolerance(inradius, triangleIntegralCorner()/2, 1e-14) {
+		t.Errorf("expected the inradius to be half the circumradius, got %f and %f", inradius, triangleIntegralCorner())
+	}
+	for k := 0; k < 3; k++ {
+		// the middles are branch points, exact when 1 - u³ is
+		omega := cmplx.Rect(1, 2*math.Pi*float64(k)/3)
+		if z := triangleIntegral(omega, 0); cmplx.Abs(z-omega*complex(inradius, 0)) > 1e-14 {
+			t.Errorf("expected %v to map to the middle of an edge %v, got %v", omega, omega*complex(inradius, 0), z)
+		}
+	}
+	// the corners, approached slowly
+	if z := triangleIntegral(-1e12, 1+1e36); !withinTolerance(real(z), -triangleIntegralCorner(), 1e-5) || !withinTolerance(imag(z), 0, 1e-14) {
+		t.Errorf("expected the negative real axis to run to the corner %f, got %v", -triangleIntegralCorner(), z)
+	}
+	// the symmetries of the triangle, and the derivative, on both sides of the cuts
+	for _, u := range []complex128{0.3, 0.5 + 0.5i, -0.8, 2i, -1 + 1.5i, 1.2 + 0.1i, 1.2 - 0.1i, 20 * cmplx.Rect(1, 0.3), 3 * cmplx.Rect(1, -1.5)} {
+		omega := cmplx.Rect(1, 2*math.Pi/3)
+		if z, rotated := triangleIntegral(u, 1-u*u*u), triangleIntegral(omega*u, 1-u*u*u); cmplx.Abs(rotated-omega*z) > 1e-13 {
+			t.Errorf("expected a third of a turn of %v to turn the integral %v, got %v", u, omega*z, rotated)
+		}
+		if z, conjugate := triangleIntegral(u, 1-u*u*u), triangleIntegral(cmplx.Conj(u), cmplx.Conj(1-u*u*u)); cmplx.Abs(conjugate-cmplx.Conj(z)) > 1e-13 {
+			t.Errorf("expected the conjugate of %v to conjugate the integral %v, got %v", u, cmplx.Conj(z), conjugate)
+		}
+		h := complex(1e-6, 0)
+		difference := (triangleIntegral(u+h, 1-(u+h)*(u+h)*(u+h)) - triangleIntegral(u-h, 1-(u-h)*(u-h)*(u-h))) / (2 * h)
+		if derivative := triangleIntegralDerivative(u); cmplx.Abs(derivative-difference) > 1e-7 {
+			t.Errorf("expected the derivative at %v to be %v, got %v", u, difference, derivative)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package flatsphere
+
+import (
+	"image"
+	"math"
+)
+
+// A fisheye lens in which the distance from the center of the image is proportional to the angle from the
+// optical axis. The optical axis is at the north pole of the view sphere, as for the other azimuthal
+// projections, and positions on the image plane are in the same units as the focal length (often pixels).
+// https://en.wikipedia.org/wiki/Fisheye_lens#Mapping_function
+type FisheyeEquidistant struct {
+	Focal float64 // The focal length of the lens.
+	FOV   float64 // The full angle (in radians) of the field of view across the image circle.
+}
+
+func NewFisheyeEquidistant(focal float64, fov float64) FisheyeEquidistant {
+	return FisheyeEquidistant{Focal: focal, FOV: fov}
+}
+
+func (f FisheyeEquidistant) Project(lat float64, lon float64) (float64, float64) {
+	return fisheyePlanar(f.Focal*(math.Pi/2-lat), lon)
+}
+
+func (f FisheyeEquidistant) Inverse(x float64, y float64) (float64, float64) {
+	r, lon := fisheyePolar(x, y)
+	return math.Pi/2 - r/f.Focal, lon
+}
+
+func (f FisheyeEquidistant) PlanarBounds() Bounds {
+	return NewCircleBounds(f.Focal * f.FOV / 2)
+}
+
+func (f FisheyeEquidistant) Describe() Metadata {
+	return fisheyeMetadata("Equidistant fisheye", Equidistant|Azimuthal)
+}
+
+// A fisheye lens preserving the relative areas of the scene, in which the distance from the center of the
+// image is proportional to the sine of half the angle from the optical axis. See FisheyeEquidistant.
+type FisheyeEquisolid struct {
+	Focal float64 // The focal length of the lens.
+	FOV   float64 // The full angle (in radians) of the field of view across the image circle, at most 2Pi.
+}
+
+func NewFisheyeEquisolid(focal float64, fov float64) FisheyeEquisolid {
+	return FisheyeEquisolid{Focal: focal, FOV: fov}
+}
+
+func (f FisheyeEquisolid) Project(lat float64, lon float64) (float64, float64) {
+	return fisheyePlanar(2*f.Focal*math.Sin((math.Pi/2-lat)/2), lon)
+}
+
+func (f FisheyeEquisolid) Inverse(x float64, y float64) (float64, float64) {
+	r, lon := fisheyePolar(x, y)
+	return math.Pi/2 - 2*math.Asin(r/(2*f.Focal)), lon
+}
+
+func (f FisheyeEquisolid) PlanarBounds() Bounds {
+	return NewCircleBounds(2 * f.Focal * math.Sin(f.FOV/4))
+}
+
+func (f FisheyeEquisolid) Describe() Metadata {
+	return fisheyeMetadata("Equisolid angle fisheye", EqualArea|Azimuthal)
+}
+
+// A fisheye lens in which the distance from the center of the image is proportional to the sine of the angle
+// from the optical axis, as in an orthographic projection of the view sphere. See FisheyeEquidistant.
+type FisheyeOrthographic struct {
+	Focal float64 // The focal length of the lens.
+	FOV   float64 // The full angle (in radians) of the field of view across the image circle, at most Pi.
+}
+
+func NewFisheyeOrthographic(focal float64, fov float64) FisheyeOrthographic {
+	return FisheyeOrthographic{Focal: focal, FOV: fov}
+}
+
+func (f FisheyeOrthographic) Project(lat float64, lon float64) (float64, float64) {
+	return fisheyePlanar(f.Focal*math.Cos(lat), lon)
+}
+
+func (f FisheyeOrthographic) Inverse(x float64, y float64) (float64, float64) {
+	r, lon := fisheyePolar(x, y)
+	return math.Acos(r / f.Focal), lon
+}
+
+func (f FisheyeOrthographic) PlanarBounds() Bounds {
+	return NewCircleBounds(f.Focal * math.Sin(f.FOV/2))
+}
+
+func (f FisheyeOrthographic) Describe() Metadata {
+	return fisheyeMetadata("Orthographic fisheye", Azimuthal)
+}
+
+// A fisheye lens preserving the shapes of small objects in the scene, in which the distance from the center
+// of the image is proportional to the tangent of half the angle from the optical axis. See FisheyeEquidistant.
+type FisheyeStereographic struct {
+	Focal float64 // The focal length of the lens.
+	FOV   float64 // The full angle (in radians) of the field of view across the image circle, less than 2Pi.
+}
+
+func NewFisheyeStereographic(focal float64, fov float64) FisheyeStereographic {
+	return FisheyeStereographic{Focal: focal, FOV: fov}
+}
+
+func (f FisheyeStereographic) Project(lat float64, lon float64) (float64, float64) {
+	return fisheyePlanar(2*f.Focal*math.Tan((math.Pi/2-lat)/2), lon)
+}
+
+func (f FisheyeStereographic) Inverse(x float64, y float64) (float64, float64) {
+	r, lon := fisheyePolar(x, y)
+	return math.Pi/2 - 2*math.Atan(r/(2*f.Focal)), lon
+}
+
+func (f FisheyeStereographic) PlanarBounds() Bounds {
+	return NewCircleBounds(2 * f.Focal * math.Tan(f.FOV/4))
+}
+
+func (f FisheyeStereographic) Describe() Metadata {
+	return fisheyeMetadata("Stereographic fisheye", Conformal|Azimuthal)
+}
+
+// The generic polynomial camera model of Kannala and Brandt (2006), as used by OpenCV's fisheye module, in
+// which the distance from the center of the image is an odd polynomial of the angle from the optical axis:
+// f(θ + k1 θ^3 + k2 θ^5 + k3 θ^7 + k4 θ^9). The polynomial must be increasing over the field of view for
+// the projection to be invertible. See FisheyeEquidistant.
+type KannalaBrandt struct {
+	Focal        float64    // The focal length of the lens.
+	FOV          float64    // The full angle (in radians) of the field of view across the image circle.
+	Coefficients [4]float64 // The distortion coefficients k1 through k4.
+}
+
+func NewKannalaBrandt(focal float64, fov float64, k1 float64, k2 float64, k3 float64, k4 float64) KannalaBrandt {
+	return KannalaBrandt{Focal: focal, FOV: fov, Coefficients: [4]float64{k1, k2, k3, k4}}
+}
+
+// The distance from the center of the image at the given angle from the optical axis, and its derivative.
+func (k KannalaBrandt) radius(theta float64) (float64, float64) {
+	r, dr := 0.0, 0.0
+	for i := len(k.Coefficients) - 1; i >= 0; i-- {
+		power := float64(2*i + 3)
+		r = (r + k.Coefficients[i]) * theta * theta
+		dr = dr*theta*theta + power*k.Coefficients[i]
+	}
+	return k.Focal * theta * (1 + r), k.Focal * (1 + dr*theta*theta)
+}
+
+func (k KannalaBrandt) Project(lat float64, lon float64) (float64, float64) {
+	r, _ := k.radius(math.Pi/2 - lat)
+	return fisheyePlanar(r, lon)
+}
+
+func (k KannalaBrandt) Inverse(x float64, y float64) (float64, float64) {
+	r, lon := fisheyePolar(x, y)
+	theta := newtonsMethod(
+		r/k.Focal,
+		func(theta float64) float64 {
+			radius, _ := k.radius(theta)
+			return radius - r
+		},
+		func(theta float64) float64 {
+			_, derivative := k.radius(theta)
+			return derivative
+		},
+		1e-12, 1e-15, 100)
+	return math.Pi/2 - theta, lon
+}
+
+func (k KannalaBrandt) PlanarBounds() Bounds {
+	r, _ := k.radius(k.FOV / 2)
+	return NewCircleBounds(r)
+}
+
+func (k KannalaBrandt) Describe() Metadata {
+	meta := fisheyeMetadata("Kannala-Brandt fisheye", Azimuthal)
+	meta.Aliases = []string{"OpenCV fisheye"}
+	return meta
+}
+
+func fisheyePlanar(r float64, lon float64) (float64, float64) {
+	return r * math.Sin(lon), -r * math.Cos(lon)
+}
+
+func fisheyePolar(x float64, y float64) (float64, float64) {
+	return math.Hypot(x, y), math.Atan2(x, -y)
+}
+
+func fisheyeMetadata(name string, properties Properties) Metadata {
+	return Metadata{
+		Name:       name,
+		Family:     FamilyAzimuthal,
+		Properties: properties,
+		Shape:      ShapeCircle,
+		Extent:     ExtentHemisphere,
+	}
+}
+
+// Convert an image taken through a fisheye lens into an equirectangular panorama of the given size, where
+// longitude -Pi is at the left edge and latitude Pi/2 at the top. The lens projection gives positions in
+// pixels relative to the principal point (cx, cy) of the image, in pixel coordinates where integers are pixel
+// centers and y increases downward. The camera looks toward the given yaw and pitch, rolled about its optical
+// axis, as in NewPanoramaView. Parts of the panorama outside the lens's field of view are left transparent.
+func EquirectangularFromFisheye(fisheye image.Image, lens Projection, cx float64, cy float64, yaw float64, pitch float64, roll float64, width int, height int) *image.RGBA64 {
+	view := NewPanoramaView(lens, yaw, pitch, roll)
+	bounds := lens.PlanarBounds()
+	imageBounds := fisheye.Bounds()
+	equirectangular := image.NewRGBA64(image.Rect(0, 0, width, height))
+	for j := 0; j < height; j++ {
+		lat := math.Pi/2 - (float64(j)+0.5)/float64(height)*math.Pi
+		for i := 0; i < width; i++ {
+			lon := (float64(i)+0.5)/float64(width)*2*math.Pi - math.Pi
+			x, y := view.Project(lat, lon)
+			if !bounds.Within(x, y) {
+				continue
+			}
+			fx := float64(imageBounds.Min.X) + cx + x
+			fy := float64(imageBounds.Min.Y) + cy - y
+			if fx < float64(imageBounds.Min.X)-0.5 || fx > float64(imageBounds.Max.X)-0.5 ||
+				fy < float64(imageBounds.Min.Y)-0.5 || fy > float64(imageBounds.Max.Y)-0.5 {
+				continue
+			}
+			equirectangular.SetRGBA64(i, j, sampleBilinear(fisheye, imageBounds, fx, fy, false))
+		}
+	}
+	return equirectangular
+}
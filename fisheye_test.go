@@ -0,0 +1,70 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFisheyeModels(t *testing.T) {
+	// radii of a point a quarter turn from the optical axis, for a unit focal length
+	testCases := []struct {
+		name   string
+		lens   Projection
+		radius float64
+	}{
+		{"Equidistant", NewFisheyeEquidistant(1, math.Pi), math.Pi / 2},
+		{"Equisolid", NewFisheyeEquisolid(1, math.Pi), math.Sqrt2},
+		{"Orthographic", NewFisheyeOrthographic(1, math.Pi), 1},
+		{"Stereographic", NewFisheyeStereographic(1, math.Pi), 2},
+		{"KannalaBrandtEquidistant", NewKannalaBrandt(1, math.Pi, 0, 0, 0, 0), math.Pi / 2},
+		{"KannalaBrandt", NewKannalaBrandt(1, math.Pi, 0.1, -0.02, 0.003, -0.0004), kannalaBrandtQuarter()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			x, y := tc.lens.Project(0, math.Pi/2)
+			if !withinTolerance(x, tc.radius, 0.000001) || !withinTolerance(y, 0, 0.000001) {
+				t.Errorf("expected %e,0, got %e,%e", tc.radius, x, y)
+			}
+			// the edge of the field of view lies on the bounding circle
+			if bounds := tc.lens.PlanarBounds(); !withinTolerance(bounds.Width()/2, tc.radius, 0.000001) {
+				t.Errorf("expected bounds radius %e, got %e", tc.radius, bounds.Width()/2)
+			}
+			for _, loc := range [][2]float64{{1.2, 0.3}, {0.4, -2.5}, {0.01, 3.0}} {
+				x, y := tc.lens.Project(loc[0], loc[1])
+				lat, lon := tc.lens.Inverse(x, y)
+				if !withinTolerance(lat, loc[0], 0.000001) || !withinTolerance(lon, loc[1], 0.000001) {
+					t.Errorf("expected %e,%e, got %e,%e", loc[0], loc[1], lat, lon)
+				}
+			}
+		})
+	}
+}
+
+func kannalaBrandtQuarter() float64 {
+	theta := math.Pi / 2
+	return theta * (1 + 0.1*math.Pow(theta, 2) - 0.02*math.Pow(theta, 4) + 0.003*math.Pow(theta, 6) - 0.0004*math.Pow(theta, 8))
+}
+
+func TestEquirectangularFromFisheye(t *testing.T) {
+	pano := gradientPanorama(360, 180)
+	// photograph the panorama through a 180 degree lens pointed at the horizon, with a focal length in pixels
+	lens := NewFisheyeEquisolid(50, math.Pi)
+	size := 150
+	view := NewPanoramaView(lens, 0.5, 0.1, 0)
+	photo := RenderPanoramaView(pano, view, NewRectangleBounds(float64(size), float64(size)), size, size)
+
+	center := float64(size)/2 - 0.5
+	back := EquirectangularFromFisheye(photo, lens, center, center, 0.5, 0.1, 0, 360, 180)
+	for _, loc := range [][2]float64{{0.1, 0.5}, {0.6, 0.2}, {-0.5, 1.2}, {0.3, -0.4}} {
+		i, j := int((loc[1]+math.Pi)/(2*math.Pi)*360), int((math.Pi/2-loc[0])/math.Pi*180)
+		want, got := pano.RGBA64At(i, j), back.RGBA64At(i, j)
+		if math.Abs(float64(want.R)-float64(got.R)) > 1000 || math.Abs(float64(want.G)-float64(got.G)) > 1000 {
+			t.Errorf("expected %v at %e,%e, got %v", want, loc[0], loc[1], got)
+		}
+	}
+	// behind the camera is outside the field of view
+	if behind := back.RGBA64At(10, 90); behind.A != 0 {
+		t.Errorf("expected transparent pixel behind the camera, got %v", behind)
+	}
+}
@@ -0,0 +1,1082 @@
+package flatsphere
+
+import "math"
+
+// Solves geodesic problems on an ellipsoid of revolution using the algorithms of C. F. F. Karney,
+// with series expansions carried to sixth order in the third flattening. Accurate to round-off
+// for terrestrial ellipsoids. Latitudes, longitudes and azimuths are in radians, azimuths measured
+// clockwise from north, and distances are in the units of the ellipsoid's semi-major axis.
+// https://en.wikipedia.org/wiki/Geodesics_on_an_ellipsoid
+// See also: "Algorithms for geodesics", https://arxiv.org/abs/1109.4448
+type Geodesic struct {
+	a    float64
+	f    float64
+	f1   float64
+	e2   float64
+	ep2  float64
+	n    float64
+	b    float64
+	c2   float64
+	etol float64
+
+	a3x [geodNA3x]float64
+	c3x [geodNC3x]float64
+	c4x [geodNC4x]float64
+}
+
+const (
+	geodOrder = 6
+	geodNA1   = geodOrder
+	geodNC1   = geodOrder
+	geodNC1p  = geodOrder
+	geodNA2   = geodOrder
+	geodNC2   = geodOrder
+	geodNA3   = geodOrder
+	geodNA3x  = geodNA3
+	geodNC3   = geodOrder
+	geodNC3x  = (geodNC3 * (geodNC3 - 1)) / 2
+	geodNC4   = geodOrder
+	geodNC4x  = (geodNC4 * (geodNC4 + 1)) / 2
+	geodMax1  = 20
+	geodMax2  = geodMax1 + 53 + 10
+)
+
+var (
+	geodTiny    = math.Sqrt(math.SmallestNonzeroFloat64 * (1 << 52))
+	geodTol0    = math.Nextafter(1, 2) - 1
+	geodTol1    = 200 * geodTol0
+	geodTol2    = math.Sqrt(geodTol0)
+	geodTolb    = geodTol0 * geodTol2
+	geodXthresh = 1000 * geodTol2
+)
+
+// Construct a geodesic solver for the given ellipsoid.
+func NewGeodesic(ellipsoid Ellipsoid) Geodesic {
+	g := Geodesic{
+		a:  ellipsoid.SemiMajorAxis,
+		f:  ellipsoid.Flattening,
+		f1: 1 - ellipsoid.Flattening,
+		e2: ellipsoid.EccentricitySquared(),
+		n:  ellipsoid.ThirdFlattening(),
+		b:  ellipsoid.SemiMinorAxis(),
+	}
+	g.ep2 = g.e2 / (g.f1 * g.f1)
+	authalic := ellipsoid.AuthalicRadius()
+	g.c2 = authalic * authalic
+	g.etol = 0.1 * geodTol2 / math.Sqrt(math.Max(0.001, math.Abs(g.f))*math.Min(1, 1-g.f/2)/2)
+	g.a3coeff()
+	g.c3coeff()
+	g.c4coeff()
+	return g
+}
+
+// Construct a geodesic solver for the WGS84 ellipsoid.
+func NewWGS84Geodesic() Geodesic {
+	return NewGeodesic(NewWGS84())
+}
+
+// The ellipsoid the geodesic problems are solved on.
+func (g Geodesic) Ellipsoid() Ellipsoid {
+	return NewEllipsoid(g.a, g.f)
+}
+
+// Solve the direct geodesic problem: find the location and forward azimuth reached by travelling the
+// given distance along the geodesic leaving the given location at the given azimuth.
+func (g Geodesic) Direct(lat1 float64, lon1 float64, azimuth1 float64, distance float64) (lat2 float64, lon2 float64, azimuth2 float64) {
+	return g.Line(lat1, lon1, azimuth1).Position(distance)
+}
+
+// Solve the inverse geodesic problem: find the shortest distance between two locations, along with
+// the azimuth of the geodesic at the first location and the forward azimuth at the second.
+func (g Geodesic) Inverse(lat1 float64, lon1 float64, lat2 float64, lon2 float64) (distance float64, azimuth1 float64, azimuth2 float64) {
+	r := g.genInverse(degrees(lat1), degrees(lon1), degrees(lat2), degrees(lon2), false)
+	return r.s12, radians(atan2d(r.salp1, r.calp1)), radians(atan2d(r.salp2, r.calp2))
+}
+
+// Construct the geodesic line passing between two locations, parameterised so that the second
+// location is reached at a distance equal to the line's Distance.
+func (g Geodesic) InverseLine(lat1 float64, lon1 float64, lat2 float64, lon2 float64) GeodesicLine {
+	r := g.genInverse(degrees(lat1), degrees(lon1), degrees(lat2), degrees(lon2), false)
+	line := g.newLine(degrees(lat1), degrees(lon1), r.salp1, r.calp1)
+	line.Distance = r.s12
+	return line
+}
+
+// Generate locations along the geodesic between two locations such that no consecutive pair is more than the
+// given maximum distance apart, including both endpoints. Results are returned as latitude/longitude pairs.
+// The maximum distance must be greater than zero.
+func (g Geodesic) Densify(lat1 float64, lon1 float64, lat2 float64, lon2 float64, maxSegment float64) [][2]float64 {
+	if !(maxSegment > 0) {
+		panic("the maximum segment length of Densify must be greater than zero")
+	}
+	line := g.InverseLine(lat1, lon1, lat2, lon2)
+	count := int(math.Ceil(line.Distance / maxSegment))
+	if count < 1 {
+		count = 1
+	}
+	path := make([][2]float64, count+1)
+	path[0] = [2]float64{lat1, lon1}
+	for i := 1; i < count; i++ {
+		lat, lon, _ := line.Position(line.Distance * float64(i) / float64(count))
+		path[i] = [2]float64{lat, lon}
+	}
+	path[count] = [2]float64{lat2, lon2}
+	return path
+}
+
+// Compute the area and perimeter of the geodesic polygon with the given vertices as latitude/longitude
+// pairs. The polygon is closed implicitly by joining the last vertex to the first. Area is positive when
+// the vertices are traversed counter-clockwise, negative when clockwise, and is in the square of the
+// ellipsoid units. Polygons may encircle a pole.
+func (g Geodesic) PolygonAreaPerimeter(vertices [][2]float64) (area float64, perimeter float64) {
+	if len(vertices) < 3 {
+		return 0, g.PolylineLength(vertices)
+	}
+	var areaSum, areaErr float64
+	crossings := 0
+	for i := range vertices {
+		from := vertices[i]
+		to := vertices[(i+1)%len(vertices)]
+		lat1, lon1 := degrees(from[0]), degrees(from[1])
+		lat2, lon2 := degrees(to[0]), degrees(to[1])
+		r := g.genInverse(lat1, lon1, lat2, lon2, true)
+		perimeter += r.s12
+		areaSum, areaErr = accumulate(areaSum, areaErr, r.S12)
+		crossings += geodTransit(lon1, lon2)
+	}
+
+	return reducePolygonArea(areaSum, areaErr, 4*math.Pi*g.c2, crossings), perimeter
+}
+
+// The total length of the geodesic path through the given latitude/longitude vertices, without closing it.
+func (g Geodesic) PolylineLength(vertices [][2]float64) float64 {
+	length := 0.0
+	for i := 1; i < len(vertices); i++ {
+		s, _, _ := g.Inverse(vertices[i-1][0], vertices[i-1][1], vertices[i][0], vertices[i][1])
+		length += s
+	}
+	return length
+}
+
+// A single geodesic on an ellipsoid leaving a starting location at a fixed azimuth, allowing repeated
+// efficient evaluation of positions along it.
+type GeodesicLine struct {
+	Distance float64 // Length of the line, when constructed between two locations by InverseLine.
+
+	g     Geodesic
+	lat1  float64
+	lon1  float64
+	salp0 float64
+	calp0 float64
+	ssig1 float64
+	csig1 float64
+	somg1 float64
+	comg1 float64
+	stau1 float64
+	ctau1 float64
+	k2    float64
+	a1m1  float64
+	b11   float64
+	a3c   float64
+	b31   float64
+	c1a   [geodNC1 + 1]float64
+	c1pa  [geodNC1p + 1]float64
+	c3a   [geodNC3]float64
+}
+
+// Construct the geodesic line leaving the given location at the given azimuth.
+func (g Geodesic) Line(lat1 float64, lon1 float64, azimuth1 float64) GeodesicLine {
+	salp1, calp1 := sincosd(angRound(degrees(azimuth1)))
+	return g.newLine(degrees(lat1), degrees(lon1), salp1, calp1)
+}
+
+func (g Geodesic) newLine(lat1 float64, lon1 float64, salp1 float64, calp1 float64) GeodesicLine {
+	l := GeodesicLine{g: g, lat1: latFix(lat1), lon1: lon1}
+
+	sbet1, cbet1 := sincosd(angRound(l.lat1))
+	sbet1 *= g.f1
+	sbet1, cbet1 = norm2(sbet1, cbet1)
+	cbet1 = math.Max(geodTiny, cbet1)
+
+	l.salp0 = salp1 * cbet1
+	l.calp0 = math.Hypot(calp1, salp1*sbet1)
+	l.ssig1 = sbet1
+	l.somg1 = l.salp0 * sbet1
+	if sbet1 != 0 || calp1 != 0 {
+		l.csig1 = cbet1 * calp1
+	} else {
+		l.csig1 = 1
+	}
+	l.comg1 = l.csig1
+	l.ssig1, l.csig1 = norm2(l.ssig1, l.csig1)
+
+	l.k2 = l.calp0 * l.calp0 * g.ep2
+	eps := l.k2 / (2*(1+math.Sqrt(1+l.k2)) + l.k2)
+
+	l.a1m1 = a1m1f(eps)
+	c1f(eps, l.c1a[:])
+	l.b11 = sinCosSeries(true, l.ssig1, l.csig1, l.c1a[:])
+	s, c := math.Sincos(l.b11)
+	l.stau1 = l.ssig1*c + l.csig1*s
+	l.ctau1 = l.csig1*c - l.ssig1*s
+	c1pf(eps, l.c1pa[:])
+
+	g.c3f(eps, l.c3a[:])
+	l.a3c = -g.f * l.salp0 * g.a3f(eps)
+	l.b31 = sinCosSeries(true, l.ssig1, l.csig1, l.c3a[:])
+	return l
+}
+
+// Find the location and forward azimuth at the given distance along the geodesic line.
+func (l GeodesicLine) Position(distance float64) (lat float64, lon float64, azimuth float64) {
+	g := l.g
+	tau12 := distance / (g.b * (1 + l.a1m1))
+	s, c := math.Sincos(tau12)
+	b12 := -sinCosSeries(true, l.stau1*c+l.ctau1*s, l.ctau1*c-l.stau1*s, l.c1pa[:])
+	sig12 := tau12 - (b12 - l.b11)
+	ssig12, csig12 := math.Sincos(sig12)
+	if math.Abs(g.f) > 0.01 {
+		// the reversion of the series is not accurate enough for highly flattened ellipsoids, take a newton step
+		ssig2 := l.ssig1*csig12 + l.csig1*ssig12
+		csig2 := l.csig1*csig12 - l.ssig1*ssig12
+		b12 = sinCosSeries(true, ssig2, csig2, l.c1a[:])
+		serr := (1+l.a1m1)*(sig12+(b12-l.b11)) - distance/g.b
+		sig12 = sig12 - serr/math.Sqrt(1+l.k2*ssig2*ssig2)
+		ssig12, csig12 = math.Sincos(sig12)
+	}
+	return l.position(sig12, ssig12, csig12)
+}
+
+// Find the location and forward azimuth at the given arc length (in radians on the auxiliary sphere)
+// along the geodesic line.
+func (l GeodesicLine) ArcPosition(arc float64) (lat float64, lon float64, azimuth float64) {
+	ssig12, csig12 := sincosd(degrees(arc))
+	return l.position(arc, ssig12, csig12)
+}
+
+func (l GeodesicLine) position(sig12 float64, ssig12 float64, csig12 float64) (float64, float64, float64) {
+	g := l.g
+	ssig2 := l.ssig1*csig12 + l.csig1*ssig12
+	csig2 := l.csig1*csig12 - l.ssig1*ssig12
+
+	sbet2 := l.calp0 * ssig2
+	cbet2 := math.Hypot(l.salp0, l.calp0*csig2)
+	if cbet2 == 0 {
+		cbet2 = geodTiny
+		csig2 = geodTiny
+	}
+	salp2 := l.salp0
+	calp2 := l.calp0 * csig2
+
+	somg2 := l.salp0 * ssig2
+	comg2 := csig2
+	omg12 := math.Atan2(somg2*l.comg1-comg2*l.somg1, comg2*l.comg1+somg2*l.somg1)
+	lam12 := omg12 + l.a3c*(sig12+(sinCosSeries(true, ssig2, csig2, l.c3a[:])-l.b31))
+	lon2 := angNormalize(angNormalize(l.lon1) + angNormalize(degrees(lam12)))
+	lat2 := atan2d(sbet2, g.f1*cbet2)
+	azi2 := atan2d(salp2, calp2)
+	return radians(lat2), radians(lon2), radians(azi2)
+}
+
+type geodInverseResult struct {
+	s12   float64
+	salp1 float64
+	calp1 float64
+	salp2 float64
+	calp2 float64
+	S12   float64
+}
+
+func (g Geodesic) genInverse(lat1 float64, lon1 float64, lat2 float64, lon2 float64, withArea bool) geodInverseResult {
+	var s12x, m12x, sig12, omg12, dnm float64
+	var salp1, calp1, salp2, calp2 float64
+	var ssig1, csig1, ssig2, csig2, eps, domg12 float64
+	somg12, comg12 := 2.0, 0.0
+
+	lon12, lon12s := angDiff(lon1, lon2)
+	lonsign := math.Copysign(1, lon12)
+	lon12 = lonsign * angRound(lon12)
+	lon12s = angRound((180 - lon12) - lonsign*lon12s)
+	lam12 := radians(lon12)
+	var slam12, clam12 float64
+	if lon12 > 90 {
+		slam12, clam12 = sincosd(lon12s)
+		clam12 = -clam12
+	} else {
+		slam12, clam12 = sincosd(lon12)
+	}
+
+	lat1 = angRound(latFix(lat1))
+	lat2 = angRound(latFix(lat2))
+	swapp := 1.0
+	if math.Abs(lat1) < math.Abs(lat2) || math.IsNaN(lat2) {
+		swapp = -1
+		lonsign = -lonsign
+		lat1, lat2 = lat2, lat1
+	}
+	latsign := math.Copysign(1, -lat1)
+	lat1 *= latsign
+	lat2 *= latsign
+
+	sbet1, cbet1 := sincosd(lat1)
+	sbet1 *= g.f1
+	sbet1, cbet1 = norm2(sbet1, cbet1)
+	cbet1 = math.Max(geodTiny, cbet1)
+	sbet2, cbet2 := sincosd(lat2)
+	sbet2 *= g.f1
+	sbet2, cbet2 = norm2(sbet2, cbet2)
+	cbet2 = math.Max(geodTiny, cbet2)
+
+	if cbet1 < -sbet1 {
+		if cbet2 == cbet1 {
+			sbet2 = math.Copysign(sbet1, sbet2)
+		}
+	} else if math.Abs(sbet2) == -sbet1 {
+		cbet2 = cbet1
+	}
+
+	dn1 := math.Sqrt(1 + g.ep2*sbet1*sbet1)
+	dn2 := math.Sqrt(1 + g.ep2*sbet2*sbet2)
+
+	var c1a [geodNC1 + 1]float64
+	var c2a [geodNC2 + 1]float64
+	var c3a [geodNC3]float64
+
+	meridian := lat1 == -90 || slam12 == 0
+	if meridian {
+		// the endpoints lie on a single meridian, so the geodesic follows it
+		calp1, salp1 = clam12, slam12
+		calp2, salp2 = 1, 0
+		ssig1, csig1 = sbet1, calp1*cbet1
+		ssig2, csig2 = sbet2, calp2*cbet2
+		sig12 = math.Atan2(math.Max(0, csig1*ssig2-ssig1*csig2)+0, csig1*csig2+ssig1*ssig2)
+		s12x, m12x, _ = g.lengths(g.n, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2, c1a[:], c2a[:])
+		if sig12 < 1 || m12x >= 0 {
+			if sig12 < 3*geodTiny || (sig12 < geodTol0 && (s12x < 0 || m12x < 0)) {
+				sig12, m12x, s12x = 0, 0, 0
+			}
+			m12x *= g.b
+			s12x *= g.b
+		} else {
+			// the geodesic passes over a pole and is not the shortest path
+			meridian = false
+		}
+	}
+
+	if !meridian && sbet1 == 0 && (g.f <= 0 || lon12s >= g.f*180) {
+		// the endpoints lie on the equator, and the geodesic follows it
+		calp1, calp2 = 0, 0
+		salp1, salp2 = 1, 1
+		s12x = g.a * lam12
+		sig12 = lam12 / g.f1
+		omg12 = sig12
+		m12x = g.b * math.Sin(sig12)
+	} else if !meridian {
+		sig12, salp1, calp1, salp2, calp2, dnm = g.inverseStart(sbet1, cbet1, dn1, sbet2, cbet2, dn2, lam12, slam12, clam12, c1a[:], c2a[:])
+		if sig12 >= 0 {
+			// short line with a good initial solution
+			s12x = sig12 * g.b * dnm
+			m12x = dnm * dnm * g.b * math.Sin(sig12/dnm)
+			omg12 = lam12 / (g.f1 * dnm)
+		} else {
+			// solve for the azimuth at the first point with newton's method, falling back on bisection
+			numit := 0
+			tripn, tripb := false, false
+			salp1a, calp1a := geodTiny, 1.0
+			salp1b, calp1b := geodTiny, -1.0
+			for ; numit < geodMax2; numit++ {
+				var v, dv float64
+				v, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, domg12, dv = g.lambda12(
+					sbet1, cbet1, dn1, sbet2, cbet2, dn2, salp1, calp1, slam12, clam12, numit < geodMax1, c1a[:], c2a[:], c3a[:])
+				tripLimit := 1.0
+				if tripn {
+					tripLimit = 8
+				}
+				if tripb || !(math.Abs(v) >= tripLimit*geodTol0) {
+					break
+				}
+				if v > 0 && (numit > geodMax1 || calp1/salp1 > calp1b/salp1b) {
+					salp1b, calp1b = salp1, calp1
+				} else if v < 0 && (numit > geodMax1 || calp1/salp1 < calp1a/salp1a) {
+					salp1a, calp1a = salp1, calp1
+				}
+				if numit < geodMax1 && dv > 0 {
+					dalp1 := -v / dv
+					if math.Abs(dalp1) < math.Pi {
+						sdalp1, cdalp1 := math.Sincos(dalp1)
+						nsalp1 := salp1*cdalp1 + calp1*sdalp1
+						if nsalp1 > 0 {
+							calp1 = calp1*cdalp1 - salp1*sdalp1
+							salp1 = nsalp1
+							salp1, calp1 = norm2(salp1, calp1)
+							tripn = math.Abs(v) <= 16*geodTol0
+							continue
+						}
+					}
+				}
+				salp1 = (salp1a + salp1b) / 2
+				calp1 = (calp1a + calp1b) / 2
+				salp1, calp1 = norm2(salp1, calp1)
+				tripn = false
+				tripb = math.Abs(salp1a-salp1)+(calp1a-calp1) < geodTolb ||
+					math.Abs(salp1-salp1b)+(calp1-calp1b) < geodTolb
+			}
+			s12x, m12x, _ = g.lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2, c1a[:], c2a[:])
+			m12x *= g.b
+			s12x *= g.b
+			if withArea {
+				sdomg12, cdomg12 := math.Sincos(domg12)
+				somg12 = slam12*cdomg12 - clam12*sdomg12
+				comg12 = clam12*cdomg12 + slam12*sdomg12
+			}
+		}
+	}
+
+	result := geodInverseResult{s12: s12x + 0}
+
+	if withArea {
+		salp0 := salp1 * cbet1
+		calp0 := math.Hypot(calp1, salp1*sbet1)
+		var S12 float64
+		if calp0 != 0 && salp0 != 0 {
+			ssig1, csig1 = sbet1, calp1*cbet1
+			ssig2, csig2 = sbet2, calp2*cbet2
+			k2 := calp0 * calp0 * g.ep2
+			eps = k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+			a4 := g.a * g.a * calp0 * salp0 * g.e2
+			ssig1, csig1 = norm2(ssig1, csig1)
+			ssig2, csig2 = norm2(ssig2, csig2)
+			var c4a [geodNC4]float64
+			g.c4f(eps, c4a[:])
+			b41 := sinCosSeries(false, ssig1, csig1, c4a[:])
+			b42 := sinCosSeries(false, ssig2, csig2, c4a[:])
+			S12 = a4 * (b42 - b41)
+		}
+		if !meridian && somg12 == 2 {
+			somg12, comg12 = math.Sincos(omg12)
+		}
+		var alp12 float64
+		if !meridian && comg12 > -0.7071 && sbet2-sbet1 < 1.75 {
+			domg12 := 1 + comg12
+			dbet1 := 1 + cbet1
+			dbet2 := 1 + cbet2
+			alp12 = 2 * math.Atan2(somg12*(sbet1*dbet2+sbet2*dbet1), domg12*(sbet1*sbet2+dbet1*dbet2))
+		} else {
+			salp12 := salp2*calp1 - calp2*salp1
+			calp12 := calp2*calp1 + salp2*salp1
+			if salp12 == 0 && calp12 < 0 {
+				salp12 = geodTiny * calp1
+				calp12 = -1
+			}
+			alp12 = math.Atan2(salp12, calp12)
+		}
+		S12 += g.c2 * alp12
+		S12 *= swapp * lonsign * latsign
+		result.S12 = S12 + 0
+	}
+
+	if swapp < 0 {
+		salp1, salp2 = salp2, salp1
+		calp1, calp2 = calp2, calp1
+	}
+	result.salp1 = salp1 * swapp * lonsign
+	result.calp1 = calp1 * swapp * latsign
+	result.salp2 = salp2 * swapp * lonsign
+	result.calp2 = calp2 * swapp * latsign
+	return result
+}
+
+// Compute the distance and reduced length (both scaled by b) of a geodesic segment, along with m0.
+func (g Geodesic) lengths(eps float64, sig12 float64, ssig1 float64, csig1 float64, dn1 float64,
+	ssig2 float64, csig2 float64, dn2 float64, c1a []float64, c2a []float64) (s12b float64, m12b float64, m0 float64) {
+	a1 := a1m1f(eps)
+	c1f(eps, c1a)
+	a2 := a2m1f(eps)
+	c2f(eps, c2a)
+	m0 = a1 - a2
+	a1 = 1 + a1
+	a2 = 1 + a2
+
+	b1 := sinCosSeries(true, ssig2, csig2, c1a) - sinCosSeries(true, ssig1, csig1, c1a)
+	s12b = a1 * (sig12 + b1)
+	b2 := sinCosSeries(true, ssig2, csig2, c2a) - sinCosSeries(true, ssig1, csig1, c2a)
+	j12 := m0*sig12 + (a1*b1 - a2*b2)
+	m12b = dn2*(csig1*ssig2) - dn1*(ssig1*csig2) - csig1*csig2*j12
+	return s12b, m12b, m0
+}
+
+func (g Geodesic) inverseStart(sbet1 float64, cbet1 float64, dn1 float64, sbet2 float64, cbet2 float64, dn2 float64,
+	lam12 float64, slam12 float64, clam12 float64, c1a []float64, c2a []float64) (sig12, salp1, calp1, salp2, calp2, dnm float64) {
+	sig12 = -1
+	salp2, calp2, dnm = math.NaN(), math.NaN(), math.NaN()
+
+	sbet12 := sbet2*cbet1 - cbet2*sbet1
+	cbet12 := cbet2*cbet1 + sbet2*sbet1
+	sbet12a := sbet2*cbet1 + cbet2*sbet1
+
+	shortline := cbet12 >= 0 && sbet12 < 0.5 && cbet2*lam12 < 0.5
+	var somg12, comg12 float64
+	if shortline {
+		sbetm2 := (sbet1 + sbet2) * (sbet1 + sbet2)
+		sbetm2 /= sbetm2 + (cbet1+cbet2)*(cbet1+cbet2)
+		dnm = math.Sqrt(1 + g.ep2*sbetm2)
+		omg12 := lam12 / (g.f1 * dnm)
+		somg12, comg12 = math.Sincos(omg12)
+	} else {
+		somg12, comg12 = slam12, clam12
+	}
+
+	salp1 = cbet2 * somg12
+	if comg12 >= 0 {
+		calp1 = sbet12 + cbet2*sbet1*somg12*somg12/(1+comg12)
+	} else {
+		calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+	}
+
+	ssig12 := math.Hypot(salp1, calp1)
+	csig12 := sbet1*sbet2 + cbet1*cbet2*comg12
+
+	if shortline && ssig12 < g.etol {
+		// really short lines are solved directly on the auxiliary sphere
+		salp2 = cbet1 * somg12
+		if comg12 >= 0 {
+			calp2 = sbet12 - cbet1*sbet2*(somg12*somg12/(1+comg12))
+		} else {
+			calp2 = sbet12 - cbet1*sbet2*(1-comg12)
+		}
+		salp2, calp2 = norm2(salp2, calp2)
+		sig12 = math.Atan2(ssig12, csig12)
+	} else if math.Abs(g.n) >= 0.1 || csig12 >= 0 || ssig12 >= 6*math.Abs(g.n)*math.Pi*cbet1*cbet1 {
+		// nothing to do, the zeroth order spherical approximation is fine
+	} else {
+		// nearly antipodal points, use the astroid solution as a starting guess
+		var x, y, lamscale, betscale float64
+		lam12x := math.Atan2(-slam12, -clam12)
+		if g.f >= 0 {
+			k2 := sbet1 * sbet1 * g.ep2
+			eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+			lamscale = g.f * cbet1 * g.a3f(eps) * math.Pi
+			betscale = lamscale * cbet1
+			x = lam12x / lamscale
+			y = sbet12a / betscale
+		} else {
+			cbet12a := cbet2*cbet1 - sbet2*sbet1
+			bet12a := math.Atan2(sbet12a, cbet12a)
+			_, m12b, m0 := g.lengths(g.n, math.Pi+bet12a, sbet1, -cbet1, dn1, sbet2, cbet2, dn2, c1a, c2a)
+			x = -1 + m12b/(cbet1*cbet2*m0*math.Pi)
+			if x < -0.01 {
+				betscale = sbet12a / x
+			} else {
+				betscale = -g.f * cbet1 * cbet1 * math.Pi
+			}
+			lamscale = betscale / cbet1
+			y = lam12x / lamscale
+		}
+
+		if y > -geodTol1 && x > -1-geodXthresh {
+			if g.f >= 0 {
+				salp1 = math.Min(1, -x)
+				calp1 = -math.Sqrt(1 - salp1*salp1)
+			} else {
+				lower := -1.0
+				if x > -geodTol1 {
+					lower = 0
+				}
+				calp1 = math.Max(lower, x)
+				salp1 = math.Sqrt(1 - calp1*calp1)
+			}
+		} else {
+			k := astroid(x, y)
+			var omg12a float64
+			if g.f >= 0 {
+				omg12a = lamscale * (-x * k / (1 + k))
+			} else {
+				omg12a = lamscale * (-y * (1 + k) / k)
+			}
+			somg12, comg12 = math.Sincos(omg12a)
+			comg12 = -comg12
+			salp1 = cbet2 * somg12
+			calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+		}
+	}
+
+	if !(salp1 <= 0) {
+		salp1, calp1 = norm2(salp1, calp1)
+	} else {
+		salp1, calp1 = 1, 0
+	}
+	return sig12, salp1, calp1, salp2, calp2, dnm
+}
+
+func (g Geodesic) lambda12(sbet1 float64, cbet1 float64, dn1 float64, sbet2 float64, cbet2 float64, dn2 float64,
+	salp1 float64, calp1 float64, slam120 float64, clam120 float64, diffp bool, c1a []float64, c2a []float64, c3a []float64,
+) (lam12, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, domg12, dlam12 float64) {
+	if sbet1 == 0 && calp1 == 0 {
+		// break degeneracy of equatorial line
+		calp1 = -geodTiny
+	}
+
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+
+	ssig1 = sbet1
+	somg1 := salp0 * sbet1
+	csig1 = calp1 * cbet1
+	comg1 := csig1
+	ssig1, csig1 = norm2(ssig1, csig1)
+
+	if cbet2 != cbet1 {
+		salp2 = salp0 / cbet2
+	} else {
+		salp2 = salp1
+	}
+	if cbet2 != cbet1 || math.Abs(sbet2) != -sbet1 {
+		var inner float64
+		if cbet1 < -sbet1 {
+			inner = (cbet2 - cbet1) * (cbet1 + cbet2)
+		} else {
+			inner = (sbet1 - sbet2) * (sbet1 + sbet2)
+		}
+		calp2 = math.Sqrt(calp1*cbet1*calp1*cbet1+inner) / cbet2
+	} else {
+		calp2 = math.Abs(calp1)
+	}
+
+	ssig2 = sbet2
+	somg2 := salp0 * sbet2
+	csig2 = calp2 * cbet2
+	comg2 := csig2
+	ssig2, csig2 = norm2(ssig2, csig2)
+
+	sig12 = math.Atan2(math.Max(0, csig1*ssig2-ssig1*csig2)+0, csig1*csig2+ssig1*ssig2)
+	somg12 := math.Max(0, comg1*somg2-somg1*comg2) + 0
+	comg12 := comg1*comg2 + somg1*somg2
+	eta := math.Atan2(somg12*clam120-comg12*slam120, comg12*clam120+somg12*slam120)
+
+	k2 := calp0 * calp0 * g.ep2
+	eps = k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+	g.c3f(eps, c3a)
+	b312 := sinCosSeries(true, ssig2, csig2, c3a) - sinCosSeries(true, ssig1, csig1, c3a)
+	domg12 = -g.f * g.a3f(eps) * salp0 * (sig12 + b312)
+	lam12 = eta + domg12
+
+	if diffp {
+		if calp2 == 0 {
+			dlam12 = -2 * g.f1 * dn1 / sbet1
+		} else {
+			_, dlam12, _ = g.lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2, c1a, c2a)
+			dlam12 *= g.f1 / (calp2 * cbet2)
+		}
+	} else {
+		dlam12 = math.NaN()
+	}
+	return
+}
+
+// Solve the astroid problem k^4+2k^3-(x^2+y^2-1)k^2-2y^2k-y^2 = 0 for its positive root.
+func astroid(x float64, y float64) float64 {
+	p := x * x
+	q := y * y
+	r := (p + q - 1) / 6
+	if q == 0 && r <= 0 {
+		return 0
+	}
+	s := p * q / 4
+	r2 := r * r
+	r3 := r * r2
+	disc := s * (s + 2*r3)
+	u := r
+	if disc >= 0 {
+		t3 := s + r3
+		if t3 < 0 {
+			t3 -= math.Sqrt(disc)
+		} else {
+			t3 += math.Sqrt(disc)
+		}
+		t := math.Cbrt(t3)
+		if t != 0 {
+			u += t + r2/t
+		} else {
+			u += t
+		}
+	} else {
+		ang := math.Atan2(math.Sqrt(-disc), -(s + r3))
+		u += 2 * r * math.Cos(ang/3)
+	}
+	v := math.Sqrt(u*u + q)
+	var uv float64
+	if u < 0 {
+		uv = q / (v - u)
+	} else {
+		uv = u + v
+	}
+	w := (uv - q) / (2 * v)
+	return uv / (math.Sqrt(uv+w*w) + w)
+}
+
+func (g Geodesic) a3f(eps float64) float64 {
+	return polyval(geodNA3x-1, g.a3x[:], eps)
+}
+
+func (g Geodesic) c3f(eps float64, c []float64) {
+	mult := 1.0
+	o := 0
+	for l := 1; l < geodNC3; l++ {
+		m := geodNC3 - l - 1
+		mult *= eps
+		c[l] = mult * polyval(m, g.c3x[o:], eps)
+		o += m + 1
+	}
+}
+
+func (g Geodesic) c4f(eps float64, c []float64) {
+	mult := 1.0
+	o := 0
+	for l := 0; l < geodNC4; l++ {
+		m := geodNC4 - l - 1
+		c[l] = mult * polyval(m, g.c4x[o:], eps)
+		o += m + 1
+		mult *= eps
+	}
+}
+
+func (g *Geodesic) a3coeff() {
+	coeff := []float64{
+		-3, 128,
+		-2, -3, 64,
+		-1, -3, -1, 16,
+		3, -1, -2, 8,
+		1, -1, 2,
+		1, 1,
+	}
+	o, k := 0, 0
+	for j := geodNA3 - 1; j >= 0; j-- {
+		m := min(geodNA3-j-1, j)
+		g.a3x[k] = polyval(m, coeff[o:], g.n) / coeff[o+m+1]
+		k++
+		o += m + 2
+	}
+}
+
+func (g *Geodesic) c3coeff() {
+	coeff := []float64{
+		3, 128,
+		2, 5, 128,
+		-1, 3, 3, 64,
+		-1, 0, 1, 8,
+		-1, 1, 4,
+		5, 256,
+		1, 3, 128,
+		-3, -2, 3, 64,
+		1, -3, 2, 32,
+		7, 512,
+		-10, 9, 384,
+		5, -9, 5, 192,
+		7, 512,
+		-14, 7, 512,
+		21, 2560,
+	}
+	o, k := 0, 0
+	for l := 1; l < geodNC3; l++ {
+		for j := geodNC3 - 1; j >= l; j-- {
+			m := min(geodNC3-j-1, j)
+			g.c3x[k] = polyval(m, coeff[o:], g.n) / coeff[o+m+1]
+			k++
+			o += m + 2
+		}
+	}
+}
+
+func (g *Geodesic) c4coeff() {
+	coeff := []float64{
+		97, 15015,
+		1088, 156, 45045,
+		-224, -4784, 1573, 45045,
+		-10656, 14144, -4576, -858, 45045,
+		64, 624, -4576, 6864, -3003, 15015,
+		100, 208, 572, 3432, -12012, 30030, 45045,
+		1, 9009,
+		-2944, 468, 135135,
+		5792, 1040, -1287, 135135,
+		5952, -11648, 9152, -2574, 135135,
+		-64, -624, 4576, -6864, 3003, 135135,
+		8, 10725,
+		1856, -936, 225225,
+		-8448, 4992, -1144, 225225,
+		-1440, 4160, -4576, 1716, 225225,
+		-136, 63063,
+		1024, -208, 105105,
+		3584, -3328, 1144, 315315,
+		-128, 135135,
+		-2560, 832, 405405,
+		128, 99099,
+	}
+	o, k := 0, 0
+	for l := 0; l < geodNC4; l++ {
+		for j := geodNC4 - 1; j >= l; j-- {
+			m := geodNC4 - j - 1
+			g.c4x[k] = polyval(m, coeff[o:], g.n) / coeff[o+m+1]
+			k++
+			o += m + 2
+		}
+	}
+}
+
+func a1m1f(eps float64) float64 {
+	coeff := []float64{1, 4, 64, 0, 256}
+	m := geodNA1 / 2
+	t := polyval(m, coeff, eps*eps) / coeff[m+1]
+	return (t + eps) / (1 - eps)
+}
+
+func c1f(eps float64, c []float64) {
+	coeff := []float64{
+		-1, 6, -16, 32,
+		-9, 64, -128, 2048,
+		9, -16, 768,
+		3, -5, 512,
+		-7, 1280,
+		-7, 2048,
+	}
+	seriesCoeffs(eps, geodNC1, coeff, c)
+}
+
+func c1pf(eps float64, c []float64) {
+	coeff := []float64{
+		205, -432, 768, 1536,
+		4005, -4736, 3840, 12288,
+		-225, 116, 384,
+		-7173, 2695, 7680,
+		3467, 7680,
+		38081, 61440,
+	}
+	seriesCoeffs(eps, geodNC1p, coeff, c)
+}
+
+func a2m1f(eps float64) float64 {
+	coeff := []float64{-11, -28, -192, 0, 256}
+	m := geodNA2 / 2
+	t := polyval(m, coeff, eps*eps) / coeff[m+1]
+	return (t - eps) / (1 + eps)
+}
+
+func c2f(eps float64, c []float64) {
+	coeff := []float64{
+		1, 2, 16, 32,
+		35, 64, 384, 2048,
+		15, 80, 768,
+		7, 35, 512,
+		63, 1280,
+		77, 2048,
+	}
+	seriesCoeffs(eps, geodNC2, coeff, c)
+}
+
+// Evaluate the even/odd polynomial coefficient table shared by the C1, C1' and C2 series.
+func seriesCoeffs(eps float64, order int, coeff []float64, c []float64) {
+	eps2 := eps * eps
+	d := eps
+	o := 0
+	for l := 1; l <= order; l++ {
+		m := (order - l) / 2
+		c[l] = d * polyval(m, coeff[o:], eps2) / coeff[o+m+1]
+		o += m + 2
+		d *= eps
+	}
+}
+
+// Evaluate the polynomial of degree n with coefficients p (highest order first) at x.
+func polyval(n int, p []float64, x float64) float64 {
+	if n < 0 {
+		return 0
+	}
+	y := p[0]
+	for i := 1; i <= n; i++ {
+		y = y*x + p[i]
+	}
+	return y
+}
+
+// Evaluate a trigonometric series sum(c[l] * sin(2*l*x)) (sinp) or sum(c[l] * cos((2*l+1)*x)) using Clenshaw summation.
+func sinCosSeries(sinp bool, sinx float64, cosx float64, c []float64) float64 {
+	k := len(c)
+	n := k
+	if sinp {
+		n--
+	}
+	ar := 2 * (cosx - sinx) * (cosx + sinx)
+	var y0, y1 float64
+	if n&1 != 0 {
+		k--
+		y0 = c[k]
+	}
+	for n /= 2; n > 0; n-- {
+		k--
+		y1 = ar*y0 - y1 + c[k]
+		k--
+		y0 = ar*y1 - y0 + c[k]
+	}
+	if sinp {
+		return 2 * sinx * cosx * y0
+	}
+	return cosx * (y0 - y1)
+}
+
+// Reduce an accumulated clockwise polygon area, held as a sum and its roundoff error, given the count of prime
+// meridian crossings by its edges, into a counter-clockwise area in the range (-total/2, total/2] for a surface
+// of the given total area.
+func reducePolygonArea(sum float64, err float64, total float64, crossings int) float64 {
+	area := math.Remainder(sum, total) + math.Remainder(err, total)
+	area = math.Remainder(area, total)
+	if crossings&1 != 0 {
+		if area < 0 {
+			area += total / 2
+		} else {
+			area -= total / 2
+		}
+	}
+	area = -area
+	if area > total/2 {
+		area -= total
+	} else if area <= -total/2 {
+		area += total
+	}
+	return area + 0
+}
+
+// Count the crossings of the prime meridian by the edge between the two longitudes, in degrees.
+func geodTransit(lon1 float64, lon2 float64) int {
+	lon12, _ := angDiff(lon1, lon2)
+	lon1 = angNormalize(lon1)
+	lon2 = angNormalize(lon2)
+	if lon12 > 0 && ((lon1 < 0 && lon2 >= 0) || (lon1 > 0 && lon2 == 0)) {
+		return 1
+	}
+	if lon12 < 0 && lon1 >= 0 && lon2 < 0 {
+		return -1
+	}
+	return 0
+}
+
+// Add a value to a running sum tracked with its roundoff error.
+func accumulate(sum float64, err float64, v float64) (float64, float64) {
+	s, t := twoSum(v, err)
+	s, e := twoSum(s, sum)
+	if s == 0 {
+		return e, t
+	}
+	return s, e + t
+}
+
+// Error-free addition of two values, returning the rounded sum and the roundoff error.
+func twoSum(u float64, v float64) (float64, float64) {
+	s := u + v
+	up := s - v
+	vpp := s - up
+	up -= u
+	vpp -= v
+	return s, -(up + vpp)
+}
+
+func norm2(x float64, y float64) (float64, float64) {
+	r := math.Hypot(x, y)
+	return x / r, y / r
+}
+
+// Round tiny angles (in degrees) so that small differences are represented exactly.
+func angRound(x float64) float64 {
+	const z = 1.0 / 16
+	y := math.Abs(x)
+	if y < z {
+		y = z - (z - y)
+	}
+	if x == 0 {
+		return x
+	}
+	return math.Copysign(y, x)
+}
+
+// Reduce an angle in degrees to the range (-180, 180].
+func angNormalize(x float64) float64 {
+	y := math.Remainder(x, 360)
+	if math.Abs(y) == 180 {
+		return math.Copysign(180, x)
+	}
+	return y
+}
+
+// The exact difference y - x of two angles in degrees reduced to (-180, 180], along with its roundoff error.
+func angDiff(x float64, y float64) (float64, float64) {
+	d, t := twoSum(math.Remainder(-x, 360), math.Remainder(y, 360))
+	d, t2 := twoSum(math.Remainder(d, 360), t)
+	if d == 0 || math.Abs(d) == 180 {
+		if t2 == 0 {
+			d = math.Copysign(d, y-x)
+		} else {
+			d = math.Copysign(d, -t2)
+		}
+	}
+	return d, t2
+}
+
+func latFix(x float64) float64 {
+	if math.Abs(x) > 90 {
+		return math.NaN()
+	}
+	return x
+}
+
+// Sine and cosine of an angle in degrees, exact for multiples of 90 degrees.
+func sincosd(x float64) (float64, float64) {
+	r := math.Mod(x, 360)
+	q := 0
+	if !math.IsNaN(r) {
+		q = int(math.Round(r / 90))
+	}
+	r -= 90 * float64(q)
+	s, c := math.Sincos(radians(r))
+	switch ((q % 4) + 4) % 4 {
+	case 1:
+		s, c = c, -s
+	case 2:
+		s, c = -s, -c
+	case 3:
+		s, c = -c, s
+	}
+	c += 0
+	if x == 0 {
+		s = x
+	}
+	return s, c
+}
+
+// The angle in degrees of the given vector, exact for multiples of 45 degrees.
+func atan2d(y float64, x float64) float64 {
+	q := 0
+	if math.Abs(y) > math.Abs(x) {
+		q = 2
+		x, y = y, x
+	}
+	if x < 0 {
+		q++
+		x = -x
+	}
+	ang := degrees(math.Atan2(y, x))
+	switch q {
+	case 1:
+		ang = math.Copysign(180, y) - ang
+	case 2:
+		ang = 90 - ang
+	case 3:
+		ang = -90 + ang
+	}
+	return ang
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
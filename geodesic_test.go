@@ -0,0 +1,196 @@
+package flatsphere
+
+import (
+	"bufio"
+	"compress/gzip"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Reference solutions computed with GeographicLib on the WGS84 ellipsoid, in degrees and meters. Distance
+// tolerances allow for the roundoff of converting degree inputs to radians.
+var geodesicReferenceCases = []struct {
+	name  string
+	lat1  float64
+	lon1  float64
+	azi1  float64
+	lat2  float64
+	lon2  float64
+	azi2  float64
+	s12   float64
+	sTol  float64
+	azTol float64
+}{
+	{"WellingtonSalamanca", -41.32, 174.81, 161.06766998615882, 40.96, -5.50, 18.825195123248392, 19959679.26735382, 1e-5, 1e-9},
+	{"QuarterEquator", 0, 0, 90, 0, 90, 90, 10018754.171394622, 1e-5, 1e-9},
+	{"QuarterMeridian", 0, 0, 0, 90, 0, 0, 10001965.7293127228, 1e-5, 1e-9},
+	{"HalfMeridian", 90, 0, 180, -90, 0, 180, 20003931.4586254456, 1e-5, 1e-9},
+}
+
+func TestGeodesicInverseReference(t *testing.T) {
+	geod := NewWGS84Geodesic()
+	for _, tc := range geodesicReferenceCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s12, azi1, azi2 := geod.Inverse(radians(tc.lat1), radians(tc.lon1), radians(tc.lat2), radians(tc.lon2))
+			if !withinTolerance(s12, tc.s12, tc.sTol) {
+				t.Errorf("expected distance %.9f, got %.9f", tc.s12, s12)
+			}
+			if !withinTolerance(degrees(azi1), tc.azi1, tc.azTol) || !withinTolerance(degrees(azi2), tc.azi2, tc.azTol) {
+				t.Errorf("expected azimuths %.12f,%.12f, got %.12f,%.12f", tc.azi1, tc.azi2, degrees(azi1), degrees(azi2))
+			}
+		})
+	}
+}
+
+func TestGeodesicDirectReference(t *testing.T) {
+	geod := NewWGS84Geodesic()
+	for _, tc := range geodesicReferenceCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lat2, lon2, azi2 := geod.Direct(radians(tc.lat1), radians(tc.lon1), radians(tc.azi1), tc.s12)
+			// the longitude of a pole is arbitrary
+			if !withinTolerance(degrees(lat2), tc.lat2, 1e-9) || (math.Abs(tc.lat2) != 90 && !withinTolerance(math.Cos(lon2-radians(tc.lon2)), 1, 1e-15)) {
+				t.Errorf("expected destination %.12f,%.12f, got %.12f,%.12f", tc.lat2, tc.lon2, degrees(lat2), degrees(lon2))
+			}
+			if math.Abs(tc.lat2) != 90 && !withinTolerance(degrees(azi2), tc.azi2, 1e-9) {
+				t.Errorf("expected azimuth %.12f, got %.12f", tc.azi2, degrees(azi2))
+			}
+		})
+	}
+}
+
+// The location of the reference dataset of WGS84 geodesics. It is computed independently of the solver in 256-bit
+// floating point by testdata/generate_geodesic_reference.go, integrating the geodesic equations numerically rather
+// than through the solver's series in the flattening.
+const geodesicReferencePath = "testdata/geodesic-reference.dat.gz"
+
+// The largest error in a distance or position Karney reports for the double precision algorithms on WGS84.
+const geodesicReferenceTolerance = 15e-9
+
+// Reads each line of the reference dataset, in order: lat1 lon1 azi1 lat2 lon2 azi2 s12 a12, with angles in degrees
+// and lengths in meters, failing the test if the dataset is missing.
+func readGeodesicReference(t *testing.T) [][8]float64 {
+	file, err := os.Open(geodesicReferencePath)
+	if err != nil {
+		t.Fatalf("could not open geodesic reference dataset: %v", err)
+	}
+	defer file.Close()
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cases [][8]float64
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 8 {
+			t.Fatalf("expected 8 fields on line %d of %s, got %d", len(cases)+1, geodesicReferencePath, len(fields))
+		}
+		var values [8]float64
+		for i, field := range fields {
+			if values[i], err = strconv.ParseFloat(field, 64); err != nil {
+				t.Fatal(err)
+			}
+		}
+		cases = append(cases, values)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(cases) == 0 {
+		t.Fatalf("no geodesics found in %s", geodesicReferencePath)
+	}
+	return cases
+}
+
+func TestGeodesicReferenceDataset(t *testing.T) {
+	geod := NewWGS84Geodesic()
+	for line, tc := range readGeodesicReference(t) {
+		lat1, lon1, azi1 := radians(tc[0]), radians(tc[1]), radians(tc[2])
+		lat2, lon2, s12 := radians(tc[3]), radians(tc[4]), tc[6]
+
+		dlat, dlon, _ := geod.Direct(lat1, lon1, azi1, s12)
+		if miss, _, _ := geod.Inverse(dlat, dlon, lat2, lon2); miss > geodesicReferenceTolerance {
+			t.Errorf("line %d: expected direct destination %.12f,%.12f, got %.12f,%.12f, %e meters away", line+1, tc[3], tc[4], degrees(dlat), degrees(dlon), miss)
+		}
+		if dist, _, _ := geod.Inverse(lat1, lon1, lat2, lon2); !withinTolerance(dist, s12, geodesicReferenceTolerance) {
+			t.Errorf("line %d: expected inverse distance %.9f, got %.9f", line+1, s12, dist)
+		}
+	}
+}
+
+func FuzzGeodesicDirectInverse(f *testing.F) {
+	geod := NewWGS84Geodesic()
+	f.Add(0.1, 0.2, 0.3, 1000.0)
+	f.Add(-1.2, 3.0, -2.0, 15000000.0)
+	f.Add(0.0, 0.0, math.Pi/2, 20000000.0)
+	f.Add(1.5, -1.0, 1.0, 5.0)
+	f.Fuzz(func(t *testing.T, lat float64, lon float64, azi float64, dist float64) {
+		lat = math.Mod(lat, math.Pi/2)
+		lon = math.Mod(lon, math.Pi)
+		azi = math.Mod(azi, math.Pi)
+		dist = math.Abs(math.Mod(dist, 19000000))
+		if math.IsNaN(lat + lon + azi + dist) {
+			t.Skip()
+		}
+		lat2, lon2, _ := geod.Direct(lat, lon, azi, dist)
+		s12, _, _ := geod.Inverse(lat, lon, lat2, lon2)
+		if !withinTolerance(s12, dist, 1e-6) {
+			t.Errorf("expected inverse distance %f, got %f", dist, s12)
+		}
+	})
+}
+
+func TestGeodesicPolygonArea(t *testing.T) {
+	geod := NewWGS84Geodesic()
+	ellipsoidArea := 4 * math.Pi * math.Pow(NewWGS84().AuthalicRadius(), 2)
+
+	// the northern hemisphere, bounded by the equator traversed eastward
+	hemisphere := [][2]float64{{0, 0}, {0, math.Pi / 2}, {0, math.Pi}, {0, -math.Pi / 2}}
+	area, perimeter := geod.PolygonAreaPerimeter(hemisphere)
+	if !withinTolerance(area, ellipsoidArea/2, 1) {
+		t.Errorf("expected hemisphere area %f, got %f", ellipsoidArea/2, area)
+	}
+	if !withinTolerance(perimeter, 2*math.Pi*6378137, 1e-6) {
+		t.Errorf("expected equator perimeter %f, got %f", 2*math.Pi*6378137, perimeter)
+	}
+
+	// an octant of the ellipsoid, traversed in both directions
+	octant := [][2]float64{{0, 0}, {0, math.Pi / 2}, {math.Pi / 2, 0}}
+	area, _ = geod.PolygonAreaPerimeter(octant)
+	if !withinTolerance(area, ellipsoidArea/8, 1) {
+		t.Errorf("expected octant area %f, got %f", ellipsoidArea/8, area)
+	}
+	reversed := [][2]float64{{math.Pi / 2, 0}, {0, math.Pi / 2}, {0, 0}}
+	area, _ = geod.PolygonAreaPerimeter(reversed)
+	if !withinTolerance(area, -ellipsoidArea/8, 1) {
+		t.Errorf("expected reversed octant area %f, got %f", -ellipsoidArea/8, area)
+	}
+}
+
+func TestGeodesicDensify(t *testing.T) {
+	geod := NewWGS84Geodesic()
+	lat1, lon1, lat2, lon2 := radians(-41.32), radians(174.81), radians(40.96), radians(-5.50)
+	path := geod.Densify(lat1, lon1, lat2, lon2, 1000000)
+	if len(path) != 21 {
+		t.Fatalf("expected 21 path points, got %d", len(path))
+	}
+	total := geod.PolylineLength(path)
+	expected, _, _ := geod.Inverse(lat1, lon1, lat2, lon2)
+	if !withinTolerance(total, expected, 1e-6) {
+		t.Errorf("expected densified length %f, got %f", expected, total)
+	}
+	for _, maxSegment := range []float64{0, -1000, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected a panic for maximum segment length %f", maxSegment)
+				}
+			}()
+			geod.Densify(lat1, lon1, lat2, lon2, maxSegment)
+		}()
+	}
+}
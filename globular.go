@@ -0,0 +1,407 @@
+package flatsphere
+
+import (
+	"math"
+)
+
+// The distance from the equator, the central meridian or a pole within which the Van der Grinten and globular
+// projections fall back to their simpler special-case formulas, where the general formulas degenerate.
+const globularTolerance = 1e-10
+
+// A compromise projection of the whole sphere into a circle, with circular arc meridians and parallels and a
+// straight equator and central meridian. Long the standard world map of the National Geographic Society.
+// https://en.wikipedia.org/wiki/Van_der_Grinten_projection
+type VanDerGrintenI struct{}
+
+func NewVanDerGrintenI() VanDerGrintenI {
+	return VanDerGrintenI{}
+}
+
+func (v VanDerGrintenI) Project(lat float64, lon float64) (float64, float64) {
+	sinTheta := math.Min(math.Abs(2*lat/math.Pi), 1)
+	if math.Abs(lat) <= globularTolerance {
+		return lon, 0
+	}
+	if math.Abs(lon) <= globularTolerance || 1-sinTheta < globularTolerance {
+		return 0, math.Copysign(math.Pi*math.Tan(math.Asin(sinTheta)/2), lat)
+	}
+	a := math.Abs(math.Pi/lon-lon/math.Pi) / 2
+	cosTheta := math.Sqrt(1 - sinTheta*sinTheta)
+	g := cosTheta / (sinTheta + cosTheta - 1)
+	p := g * (2/sinTheta - 1)
+	p2 := p * p
+	// rationalized to avoid cancellation near the central meridian, and the height taken from the meridian circle
+	b := a * (p2 - g)
+	x1 := (p2 - g*g) / (b + math.Sqrt(b*b+(p2+a*a)*(p2-g*g)))
+	y1 := math.Sqrt(math.Max(0, 1-x1*(x1+2*a)))
+	return withinCircle(math.Copysign(math.Pi*x1, lon), math.Copysign(math.Pi*y1, lat), math.Pi)
+}
+
+func (v VanDerGrintenI) Inverse(x float64, y float64) (float64, float64) {
+	if x == 0 && y == 0 {
+		return 0, 0
+	}
+	// the latitude is the root of a cubic, solved here in its trigonometric form
+	xs, ys := x/math.Pi, math.Abs(y/math.Pi)
+	r2 := xs*xs + ys*ys
+	c1 := -ys * (1 + r2)
+	c2 := c1 - 2*ys*ys + xs*xs
+	c3 := -2*c1 + 1 + 2*ys*ys + r2*r2
+	d := ys*ys/c3 + (2*c2*c2*c2/(c3*c3*c3)-9*c1*c2/(c3*c3))/27
+	a1 := (c1 - c2*c2/(3*c3)) / c3
+	m1 := 2 * math.Sqrt(-a1/3)
+	theta1 := math.Acos(math.Max(-1, math.Min(1, 3*d/(a1*m1)))) / 3
+	lat := math.Pi * (-m1*math.Cos(theta1+math.Pi/3) - c2/(3*c3))
+	if ys == 0 {
+		lat = 0
+	}
+	return math.Copysign(lat, y), meridianArcLongitude(x, y, math.Pi)
+}
+
+func (v VanDerGrintenI) PlanarBounds() Bounds {
+	return NewCircleBounds(math.Pi)
+}
+
+func (v VanDerGrintenI) Describe() Metadata {
+	return Metadata{
+		Name:     "Van der Grinten I",
+		Shape:    ShapeCircle,
+		Extent:   ExtentWorld,
+		PROJName: "vandg",
+	}
+}
+
+// A variant of the Van der Grinten projection in which the parallels cross the meridians at right angles, giving
+// it less exaggeration of the polar regions than the original.
+// https://en.wikipedia.org/wiki/Van_der_Grinten_projection
+type VanDerGrintenII struct{}
+
+func NewVanDerGrintenII() VanDerGrintenII {
+	return VanDerGrintenII{}
+}
+
+func (v VanDerGrintenII) Project(lat float64, lon float64) (float64, float64) {
+	sinTheta := math.Min(math.Abs(2*lat/math.Pi), 1)
+	cosTheta := math.Sqrt(1 - sinTheta*sinTheta)
+	if math.Abs(lon) <= globularTolerance {
+		return 0, math.Copysign(math.Pi*sinTheta/(1+cosTheta), lat)
+	}
+	a := math.Abs(math.Pi/lon-lon/math.Pi) / 2
+	x1 := cosTheta / (math.Sqrt(1+a*a) + a*cosTheta)
+	y1 := math.Sqrt(1+a*a) * sinTheta / (math.Sqrt(1+a*a) + a*cosTheta)
+	return withinCircle(math.Copysign(math.Pi*x1, lon), math.Copysign(math.Pi*y1, lat), math.Pi)
+}
+
+func (v VanDerGrintenII) Inverse(x float64, y float64) (float64, float64) {
+	xs, ys := x/math.Pi, y/math.Pi
+	return math.Pi * ys / (1 + xs*xs + ys*ys), meridianArcLongitude(x, y, math.Pi)
+}
+
+func (v VanDerGrintenII) PlanarBounds() Bounds {
+	return NewCircleBounds(math.Pi)
+}
+
+func (v VanDerGrintenII) Describe() Metadata {
+	return Metadata{
+		Name:     "Van der Grinten II",
+		Shape:    ShapeCircle,
+		Extent:   ExtentWorld,
+		PROJName: "vandg2",
+	}
+}
+
+// A variant of the Van der Grinten projection with straight parallels, spaced as along the central meridian
+// of the original.
+// https://en.wikipedia.org/wiki/Van_der_Grinten_projection
+type VanDerGrintenIII struct{}
+
+func NewVanDerGrintenIII() VanDerGrintenIII {
+	return VanDerGrintenIII{}
+}
+
+func (v VanDerGrintenIII) Project(lat float64, lon float64) (float64, float64) {
+	sinTheta := math.Min(math.Abs(2*lat/math.Pi), 1)
+	y1 := sinTheta / (1 + math.Sqrt(1-sinTheta*sinTheta))
+	if math.Abs(lon) <= globularTolerance || 1-sinTheta < globularTolerance {
+		return 0, math.Copysign(math.Pi*y1, lat)
+	}
+	a := math.Abs(math.Pi/lon-lon/math.Pi) / 2
+	x1 := (1 - y1*y1) / (math.Sqrt(a*a+1-y1*y1) + a)
+	return withinCircle(math.Copysign(math.Pi*x1, lon), math.Copysign(math.Pi*y1, lat), math.Pi)
+}
+
+func (v VanDerGrintenIII) Inverse(x float64, y float64) (float64, float64) {
+	ys := y / math.Pi
+	return math.Pi * ys / (1 + ys*ys), meridianArcLongitude(x, y, math.Pi)
+}
+
+func (v VanDerGrintenIII) PlanarBounds() Bounds {
+	return NewCircleBounds(math.Pi)
+}
+
+func (v VanDerGrintenIII) Describe() Metadata {
+	return Metadata{
+		Name:     "Van der Grinten III",
+		Shape:    ShapeCircle,
+		Extent:   ExtentWorld,
+		PROJName: "vandg3",
+	}
+}
+
+// The 'apple-shaped' variant of the Van der Grinten projection, with the central meridian and equator at true
+// scale and the outer meridians bulging beyond the poles.
+// https://en.wikipedia.org/wiki/Van_der_Grinten_projection
+type VanDerGrintenIV struct{}
+
+func NewVanDerGrintenIV() VanDerGrintenIV {
+	return VanDerGrintenIV{}
+}
+
+func (v VanDerGrintenIV) Project(lat float64, lon float64) (float64, float64) {
+	if math.Abs(lat) < globularTolerance {
+		return lon, 0
+	}
+	if math.Abs(lon) < globularTolerance || math.Abs(math.Abs(lat)-math.Pi/2) < globularTolerance {
+		return 0, lat
+	}
+	// the parallels are arcs of circles centered on the central meridian, shrinking towards the poles
+	bt := math.Abs(2 * lat / math.Pi)
+	radius := math.Pi / 2 * (1 - bt) * (bt*bt + 2*bt + 5) / (2 * bt * bt)
+	x, y := globularIntersection(math.Abs(lat), math.Abs(lon), radius)
+	return math.Copysign(x, lon), math.Copysign(y, lat)
+}
+
+func (v VanDerGrintenIV) Inverse(x float64, y float64) (float64, float64) {
+	lon := meridianArcLongitude(x, y, math.Pi/2)
+	return globularLatitude(v, lon, x, y), lon
+}
+
+func (v VanDerGrintenIV) PlanarBounds() Bounds {
+	return NewRectangleBounds(2*math.Pi, 5*math.Pi/4)
+}
+
+func (v VanDerGrintenIV) Describe() Metadata {
+	return Metadata{
+		Name:     "Van der Grinten IV",
+		Shape:    ShapeOther,
+		Extent:   ExtentWorld,
+		PROJName: "vandg4",
+	}
+}
+
+// A hemispheric globular projection with the central meridian and equator divided evenly and the parallels
+// dividing the bounding circle evenly, long used for maps of the eastern and western hemispheres. Locations in
+// the far hemisphere are mirrored onto the near one.
+// https://en.wikipedia.org/wiki/Nicolosi_globular_projection
+type NicolosiGlobular struct{}
+
+func NewNicolosiGlobular() NicolosiGlobular {
+	return NicolosiGlobular{}
+}
+
+func (n NicolosiGlobular) Project(lat float64, lon float64) (float64, float64) {
+	if math.Abs(lon) > math.Pi/2 {
+		lon = math.Copysign(math.Pi, lon) - lon
+	}
+	if math.Abs(lon) < globularTolerance || math.Abs(math.Abs(lat)-math.Pi/2) < globularTolerance {
+		return 0, lat
+	}
+	if math.Abs(lat) < globularTolerance {
+		return lon, 0
+	}
+	// each parallel is the arc through its latitude on the central meridian and the points dividing the
+	// bounding circle evenly, written in terms of the distance to the pole to keep its precision there
+	toPole := math.Pi/2 - math.Abs(lat)
+	versine := 2 * math.Sin(toPole/2) * math.Sin(toPole/2)
+	radius := (toPole*toPole + math.Pi*math.Abs(lat)*versine) / (2 * (toPole - math.Pi/2*versine))
+	x, y := globularIntersection(math.Abs(lat), math.Abs(lon), radius)
+	return withinCircle(math.Copysign(x, lon), math.Copysign(y, lat), math.Pi/2)
+}
+
+func (n NicolosiGlobular) Inverse(x float64, y float64) (float64, float64) {
+	lon := meridianArcLongitude(x, y, math.Pi/2)
+	return globularLatitude(n, lon, x, y), lon
+}
+
+func (n NicolosiGlobular) PlanarBounds() Bounds {
+	return NewCircleBounds(math.Pi / 2)
+}
+
+func (n NicolosiGlobular) Describe() Metadata {
+	return Metadata{
+		Name:     "Nicolosi globular",
+		Shape:    ShapeCircle,
+		Extent:   ExtentHemisphere,
+		PROJName: "nicol",
+	}
+}
+
+// A globular projection with straight, evenly spaced parallels and circular arc meridians evenly spaced along
+// the equator, through the poles within the central hemisphere.
+// https://en.wikipedia.org/wiki/Apian_globular_projection
+type ApianGlobular struct{}
+
+func NewApianGlobular() ApianGlobular {
+	return ApianGlobular{}
+}
+
+func (a ApianGlobular) Project(lat float64, lon float64) (float64, float64) {
+	return apianMeridianX(lat, lon), lat
+}
+
+func (a ApianGlobular) Inverse(x float64, y float64) (float64, float64) {
+	return y, meridianArcLongitude(x, y, math.Pi/2)
+}
+
+func (a ApianGlobular) PlanarBounds() Bounds {
+	return NewRectangleBounds(2*math.Pi, math.Pi)
+}
+
+func (a ApianGlobular) Describe() Metadata {
+	return Metadata{
+		Name:     "Apian globular I",
+		Shape:    ShapeFlatPolar,
+		Extent:   ExtentWorld,
+		PROJName: "apian",
+	}
+}
+
+// A globular projection with the meridians of the Apian globular projection, but with the parallels spaced
+// as the sine of the latitude, like in an orthographic view of the central meridian.
+// https://en.wikipedia.org/wiki/Roger_Bacon
+type BaconGlobular struct{}
+
+func NewBaconGlobular() BaconGlobular {
+	return BaconGlobular{}
+}
+
+func (b BaconGlobular) Project(lat float64, lon float64) (float64, float64) {
+	y := math.Pi / 2 * math.Sin(lat)
+	return apianMeridianX(y, lon), y
+}
+
+func (b BaconGlobular) Inverse(x float64, y float64) (float64, float64) {
+	return math.Asin(math.Max(-1, math.Min(1, 2*y/math.Pi))), meridianArcLongitude(x, y, math.Pi/2)
+}
+
+func (b BaconGlobular) PlanarBounds() Bounds {
+	return NewRectangleBounds(2*math.Pi, math.Pi)
+}
+
+func (b BaconGlobular) Describe() Metadata {
+	return Metadata{
+		Name:     "Bacon globular",
+		Shape:    ShapeFlatPolar,
+		Extent:   ExtentWorld,
+		PROJName: "bacon",
+	}
+}
+
+// An oval projection of the whole sphere, matching the Apian globular projection within the central hemisphere
+// and drawing the outer meridians as evenly spaced semicircles, so that the poles become lines.
+// https://en.wikipedia.org/wiki/Ortelius_oval_projection
+type OrteliusOval struct{}
+
+func NewOrteliusOval() OrteliusOval {
+	return OrteliusOval{}
+}
+
+func (o OrteliusOval) Project(lat float64, lon float64) (float64, float64) {
+	if math.Abs(lon) < math.Pi/2 {
+		return apianMeridianX(lat, lon), lat
+	}
+	return math.Copysign(math.Abs(lon)-math.Pi/2+math.Sqrt(math.Max(0, math.Pi*math.Pi/4-lat*lat)), lon), lat
+}
+
+func (o OrteliusOval) Inverse(x float64, y float64) (float64, float64) {
+	halfChord := math.Sqrt(math.Max(0, math.Pi*math.Pi/4-y*y))
+	if math.Abs(x) < halfChord {
+		return y, meridianArcLongitude(x, y, math.Pi/2)
+	}
+	return y, math.Copysign(math.Abs(x)-halfChord+math.Pi/2, x)
+}
+
+func (o OrteliusOval) PlanarBounds() Bounds {
+	return NewRectangleBounds(2*math.Pi, math.Pi)
+}
+
+func (o OrteliusOval) Describe() Metadata {
+	return Metadata{
+		Name:     "Ortelius oval",
+		Shape:    ShapeFlatPolar,
+		Extent:   ExtentWorld,
+		PROJName: "ortel",
+	}
+}
+
+// The horizontal position at height y of the Apian globular meridian, the arc of the circle through the
+// poles and the equator at the given longitude.
+func apianMeridianX(y float64, lon float64) float64 {
+	if math.Abs(lon) < globularTolerance {
+		return 0
+	}
+	radius := (math.Pi*math.Pi/(4*math.Abs(lon)) + math.Abs(lon)) / 2
+	return math.Copysign(math.Abs(lon)-y*y/(radius+math.Sqrt(radius*radius-y*y)), lon)
+}
+
+// The point in the upper right quadrant where the meridian at lon, the arc of the circle through the poles and
+// the equator at lon, crosses the parallel at lat, the lower arc of the circle of the given radius centered on
+// the central meridian above lat. The quadratic for x is written in factored forms that hold their precision
+// both near the equator, where the radius grows without bound, and near the poles, where the two arcs meet.
+func globularIntersection(lat float64, lon float64, radius float64) (float64, float64) {
+	b := math.Pi / 2
+	center := lat + radius
+	spread := lon - b*b/lon
+	below := (b - lat) * (b - lat - 2*radius) / (2 * center)
+	qa := 1 + spread*spread/(4*center*center)
+	qb := spread * ((b-lat)*(b+lat) - 2*radius*center) / (2 * center * center)
+	qc := below * (below + 2*b)
+	disc := math.Sqrt(math.Max(0, qb*qb-4*qa*qc))
+	x := (disc - qb) / (2 * qa)
+	if qb > 0 {
+		x = -2 * qc / (qb + disc)
+	}
+	return x, lat + x*x/(radius+math.Sqrt(math.Max(0, radius*radius-x*x)))
+}
+
+// Pulls a point on the outline of a circle of the given radius that rounding has left just outside it back in,
+// so that it stays within the circular bounds.
+func withinCircle(x float64, y float64, radius float64) (float64, float64) {
+	for x*x+y*y > radius*radius {
+		x, y = math.Nextafter(x, 0), math.Nextafter(y, 0)
+	}
+	return x, y
+}
+
+// The longitude of the meridian through x, y in projections whose meridians are arcs of circles through the
+// poles at (0, ±poleY), crossing the equator at a distance from the center equal to their longitude.
+func meridianArcLongitude(x float64, y float64, poleY float64) float64 {
+	if x == 0 {
+		return 0
+	}
+	s := poleY*poleY - x*x - y*y
+	root := math.Hypot(s, 2*x*poleY)
+	// the two forms are equal, each avoiding cancellation on one side of the circle through the poles
+	if s >= 0 {
+		return 2 * x * poleY * poleY / (root + s)
+	}
+	return math.Copysign(root-s, x) / (2 * math.Abs(x))
+}
+
+// The latitude of x, y along the circular arc meridian at the given longitude, found by bisecting on the
+// distance from where the meridian crosses the equator, which grows steadily towards the pole.
+func globularLatitude(proj Projection, lon float64, x float64, y float64) float64 {
+	eqX, _ := proj.Project(0, lon)
+	target := (x-eqX)*(x-eqX) + y*y
+	low, high := 0.0, math.Pi/2
+	for i := 0; i < 64 && low < high; i++ {
+		mid := (low + high) / 2
+		mx, my := proj.Project(mid, lon)
+		if (mx-eqX)*(mx-eqX)+my*my < target {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return math.Copysign((low+high)/2, y)
+}
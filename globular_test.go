@@ -0,0 +1,111 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGlobularLandmarks(t *testing.T) {
+	b := math.Pi / 2
+	testCases := []struct {
+		name     string
+		proj     Projection
+		lat, lon float64
+		x, y     float64
+	}{
+		{"VanDerGrintenICenter", NewVanDerGrintenI(), 0, 0, 0, 0},
+		{"VanDerGrintenINorthPole", NewVanDerGrintenI(), 90, 0, 0, math.Pi},
+		{"VanDerGrintenIEquator", NewVanDerGrintenI(), 0, 90, b, 0},
+		{"VanDerGrintenIAntimeridian", NewVanDerGrintenI(), 0, -180, -math.Pi, 0},
+		{"VanDerGrintenICentralMeridian", NewVanDerGrintenI(), 45, 0, 0, math.Pi * math.Tan(math.Pi/12)},
+		{"VanDerGrintenIGeneral", NewVanDerGrintenI(), 50, -160, -2.5745709, 1.1538901},
+		{"VanDerGrintenIINorthPole", NewVanDerGrintenII(), 90, 100, 0, math.Pi},
+		{"VanDerGrintenIIEquator", NewVanDerGrintenII(), 0, -120, -2 * math.Pi / 3, 0},
+		{"VanDerGrintenIICentralMeridian", NewVanDerGrintenII(), 45, 0, 0, math.Pi * math.Tan(math.Pi/12)},
+		{"VanDerGrintenIIISouthPole", NewVanDerGrintenIII(), -90, 45, 0, -math.Pi},
+		{"VanDerGrintenIIIEquator", NewVanDerGrintenIII(), 0, 135, 3 * math.Pi / 4, 0},
+		{"VanDerGrintenIIIOutline", NewVanDerGrintenIII(), 45, 180, math.Pi * math.Sqrt(1-(2-math.Sqrt(3))*(2-math.Sqrt(3))), math.Pi * (2 - math.Sqrt(3))},
+		{"VanDerGrintenIVNorthPole", NewVanDerGrintenIV(), 90, 60, 0, b},
+		{"VanDerGrintenIVEquator", NewVanDerGrintenIV(), 0, 180, math.Pi, 0},
+		{"VanDerGrintenIVCentralMeridian", NewVanDerGrintenIV(), -60, 0, 0, -math.Pi / 3},
+		{"VanDerGrintenIVOutline", NewVanDerGrintenIV(), 0.5 * 180 / math.Pi, 180, 3.030248993, 0.651803746},
+		{"VanDerGrintenIVGeneral", NewVanDerGrintenIV(), 1.1 * 180 / math.Pi, -180, -2.008220687, 1.779384526},
+		{"NicolosiEquator", NewNicolosiGlobular(), 0, 45, math.Pi / 4, 0},
+		{"NicolosiCentralMeridian", NewNicolosiGlobular(), 40, 0, 0, 40 * math.Pi / 180},
+		{"NicolosiOutline", NewNicolosiGlobular(), 30, 90, b * math.Sqrt(3) / 2, b / 2},
+		{"NicolosiFarSide", NewNicolosiGlobular(), -30, -90, -b * math.Sqrt(3) / 2, -b / 2},
+		{"ApianOutline", NewApianGlobular(), 60, 90, math.Pi * math.Sqrt(5) / 6, math.Pi / 3},
+		{"ApianAntimeridian", NewApianGlobular(), 0, 180, math.Pi, 0},
+		{"BaconOutline", NewBaconGlobular(), 30, 90, math.Pi * math.Sqrt(3) / 4, math.Pi / 4},
+		{"BaconNorthPole", NewBaconGlobular(), 90, 0, 0, b},
+		{"OrteliusNorthPole", NewOrteliusOval(), 90, 180, b, b},
+		{"OrteliusAntimeridian", NewOrteliusOval(), 0, -180, -math.Pi, 0},
+		{"OrteliusOuter", NewOrteliusOval(), 60, 135, math.Pi/4 + math.Pi*math.Sqrt(5)/6, math.Pi / 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			x, y := tc.proj.Project(tc.lat*math.Pi/180, tc.lon*math.Pi/180)
+			if !withinTolerance(x, tc.x, 1e-7) || !withinTolerance(y, tc.y, 1e-7) {
+				t.Errorf("expected %f,%f, got %f,%f", tc.x, tc.y, x, y)
+			}
+		})
+	}
+}
+
+func TestGlobularMeridianArcs(t *testing.T) {
+	testCases := []struct {
+		proj  Projection
+		poleY float64
+	}{
+		{NewVanDerGrintenI(), math.Pi},
+		{NewVanDerGrintenII(), math.Pi},
+		{NewVanDerGrintenIII(), math.Pi},
+		{NewVanDerGrintenIV(), math.Pi / 2},
+		{NewNicolosiGlobular(), math.Pi / 2},
+		{NewApianGlobular(), math.Pi / 2},
+	}
+	for _, tc := range testCases {
+		for lat := -85.0; lat < 86; lat += 8.5 {
+			for lon := -170.0; lon < 171; lon += 17 {
+				if _, ok := tc.proj.(NicolosiGlobular); ok && math.Abs(lon) > 90 {
+					continue
+				}
+				x, y := tc.proj.Project(lat*math.Pi/180, lon*math.Pi/180)
+				if arcLon := meridianArcLongitude(x, y, tc.poleY); !withinTolerance(arcLon, lon*math.Pi/180, 1e-12) {
+					t.Errorf("expected %T to place %f,%f on the meridian arc at %f, got %f", tc.proj, lat, lon, lon, arcLon*180/math.Pi)
+				}
+			}
+		}
+	}
+}
+
+func TestGlobularProjectInverse(t *testing.T) {
+	projections := []Projection{
+		NewVanDerGrintenI(), NewVanDerGrintenII(), NewVanDerGrintenIII(), NewVanDerGrintenIV(),
+		NewNicolosiGlobular(), NewApianGlobular(), NewBaconGlobular(), NewOrteliusOval(),
+	}
+	for _, proj := range projections {
+		for lat := -89.5; lat < 90; lat += 4.9 {
+			for lon := -180.0; lon <= 180; lon += 6.1 {
+				if _, ok := proj.(NicolosiGlobular); ok && math.Abs(lon) > 90 {
+					continue
+				}
+				x, y := proj.Project(lat*math.Pi/180, lon*math.Pi/180)
+				if !proj.PlanarBounds().Within(x, y) {
+					t.Errorf("expected %T to project %f,%f within its bounds, got %f,%f", proj, lat, lon, x, y)
+				}
+				tolerance := 1e-12
+				if _, ok := proj.(VanDerGrintenI); ok {
+					// the cubic for the latitude has a double root along the outer meridians, where its closed form
+					// is only accurate to about 1e-8
+					tolerance = 1e-7
+				}
+				rlat, rlon := proj.Inverse(x, y)
+				if distance := GreatCircleDistance(lat*math.Pi/180, lon*math.Pi/180, rlat, rlon); distance > tolerance {
+					t.Errorf("expected %T to invert %f,%f, got %f,%f (off by %e)", proj, lat, lon, rlat*180/math.Pi, rlon*180/math.Pi, distance)
+				}
+			}
+		}
+	}
+}
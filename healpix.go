@@ -60,3 +60,15 @@ func (h HEALPixStandard) PlanarBounds() Bounds {
 		YMax: math.Pi / 2,
 	}
 }
+
+func (h HEALPixStandard) Describe() Metadata {
+	return Metadata{
+		Name:       "HEALPix",
+		Aliases:    []string{"Hierarchical Equal Area isoLatitude Pixelization"},
+		Family:     FamilyPseudocylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeOther,
+		Extent:     ExtentWorld,
+		PROJName:   "healpix",
+	}
+}
@@ -9,6 +9,10 @@ func FuzzMercatorProjectInverse(f *testing.F) {
 	projectInverseFuzz(f, NewMercator())
 }
 
+func FuzzMercatorTrueScaleProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewMercatorTrueScale(40*math.Pi/180))
+}
+
 func FuzzPlateCarreeProjectInverse(f *testing.F) {
 	projectInverseFuzz(f, NewPlateCarree())
 }
@@ -45,10 +49,26 @@ func FuzzMillerProjectInverse(f *testing.F) {
 	projectInverseFuzz(f, NewMiller())
 }
 
+func FuzzMillerTrueScaleProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewMillerTrueScale(30*math.Pi/180))
+}
+
 func FuzzCentralProjectInverse(f *testing.F) {
 	projectInverseFuzz(f, NewCentral())
 }
 
+func FuzzAlbersProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewAlbers(29.5*math.Pi/180, 45.5*math.Pi/180))
+}
+
+func FuzzLambertConformalConicProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewLambertConformalConic(33*math.Pi/180, 45*math.Pi/180))
+}
+
+func FuzzLambertConformalConicSouthProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewLambertConformalConic(-40*math.Pi/180, -40*math.Pi/180))
+}
+
 func FuzzSinusoidalProjectInverse(f *testing.F) {
 	projectInverseFuzz(f, NewSinusoidal())
 }
@@ -81,9 +101,31 @@ func FuzzHEALPixStandardProjectInverse(f *testing.F) {
 //	projectInverseFuzz(f, NewLambertAzimuthal())
 //}
 
-//func FuzzTransverseMercatorProjectInverse(f *testing.F) {
-//	projectInverseFuzz(f, NewObliqueProjection(NewMercator(), 0, math.Pi/2, -math.Pi/2))
-//}
+func FuzzTransverseMercatorProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewTransverseMercator(0, 0.3, 0.9996))
+}
+
+func FuzzLoximuthalProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewLoximuthal(40*math.Pi/180))
+}
+
+func FuzzMeridianShiftSinusoidalProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewMeridianShift(NewSinusoidal(), 2.5))
+}
+
+func FuzzFisheyeEquidistantProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewFisheyeEquidistant(1, 2*math.Pi))
+}
+
+func FuzzKannalaBrandtProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewKannalaBrandt(300, 3.5, 0.02, -0.003, 0.0004, -0.00002))
+}
+
+func FuzzSnyderIcosahedralProjectInverse(f *testing.F) {
+	// 180 degrees near the south pole
+	f.Add(-83.23811865456315, 219.9114857512855)
+	projectInverseDistanceFuzz(f, NewSnyderIcosahedral(), nil)
+}
 
 func FuzzRobinsonProjectInverse(f *testing.F) {
 	projectInverseFuzz(f, NewRobinson())
@@ -93,9 +135,9 @@ func FuzzNaturalEarthProjectInverse(f *testing.F) {
 	projectInverseFuzz(f, NewNaturalEarth())
 }
 
-//func FuzzEqualEarthProjectInverse(f *testing.F) {
-//	projectInverseFuzz(f, NewEqualEarth())
-//}
+func FuzzEqualEarthProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewEqualEarth())
+}
 
 func FuzzCassiniProjectInverse(f *testing.F) {
 	projectInverseFuzz(f, NewCassini())
@@ -117,6 +159,188 @@ func FuzzVerticalPerspectiveInverse(f *testing.F) {
 	projectInverseFuzz(f, NewVerticalPerspective(6))
 }
 
+func FuzzPeirceQuincuncialProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewPeirceQuincuncial())
+}
+
+func FuzzGuyouProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewGuyou())
+}
+
+func FuzzAdamsWorldInASquareIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewAdamsWorldInASquareI())
+}
+
+func FuzzAdamsWorldInASquareIIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewAdamsWorldInASquareII())
+}
+
+func FuzzLeeTetrahedralProjectInverse(f *testing.F) {
+	// just short of 180 degrees, near the north pole
+	f.Add(7286.902001294993, 94.24777960769379)
+	projectInverseDistanceFuzz(f, NewLeeTetrahedral(), nil)
+}
+
+func FuzzLeeTetrahedralRectangleProjectInverse(f *testing.F) {
+	// just short of 180 degrees, through the middle of the rectangle
+	f.Add(267.0243363943376, -47.12388980384689)
+	// near the pole, where the first step from the gnomonic face passes a corner
+	f.Add(3140.017702849743, -1.5707963267948966)
+	projectInverseDistanceFuzz(f, NewLeeTetrahedralRectangle(), nil)
+}
+
+func FuzzConformalIcosahedralProjectInverse(f *testing.F) {
+	// just short of 180 degrees, through the middle of a face
+	f.Add(6.142699081698723, 47.12388980384689)
+	f.Add(327.28318530717956, 47.12388980384689)
+	projectInverseDistanceFuzz(f, NewConformalIcosahedral(), nil)
+}
+
+func FuzzVanDerGrintenIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewVanDerGrintenI())
+}
+
+func FuzzVanDerGrintenIIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewVanDerGrintenII())
+}
+
+func FuzzVanDerGrintenIIIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewVanDerGrintenIII())
+}
+
+func FuzzVanDerGrintenIVProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewVanDerGrintenIV())
+}
+
+func FuzzNicolosiGlobularProjectInverse(f *testing.F) {
+	f.Add(0.7, 1.2)
+	f.Add(-1.4, -0.1)
+	// the far hemisphere is mirrored onto the near one
+	projectInverseDistanceFuzz(f, NewNicolosiGlobular(), func(lat float64, lon float64, x float64, y float64) bool {
+		return math.Abs(lon) > math.Pi/2
+	})
+}
+
+func FuzzApianGlobularProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewApianGlobular())
+}
+
+func FuzzBaconGlobularProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewBaconGlobular())
+}
+
+func FuzzOrteliusOvalProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewOrteliusOval())
+}
+
+func FuzzEckertIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewEckertI())
+}
+
+func FuzzEckertIIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewEckertII())
+}
+
+func FuzzEckertIIIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewEckertIII())
+}
+
+func FuzzEckertVProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewEckertV())
+}
+
+func FuzzEckertVIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewEckertVI())
+}
+
+func FuzzWagnerIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewWagnerI())
+}
+
+func FuzzWagnerIIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewWagnerII())
+}
+
+func FuzzWagnerIIIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewWagnerIII(0))
+}
+
+func FuzzWagnerIVProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewWagnerIV())
+}
+
+func FuzzWagnerVProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewWagnerV())
+}
+
+func FuzzWagnerVIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewWagnerVI())
+}
+
+func FuzzWagnerVIIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewWagnerVII())
+}
+
+func FuzzWagnerVIIIProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewWagnerVIII())
+}
+
+func FuzzWagnerIXProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewWagnerIX())
+}
+
+func FuzzHammerWagnerProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewHammerWagner(math.Pi/3, math.Pi/2, 0, 2))
+}
+
+func FuzzEckertGreifendorffProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewEckertGreifendorff())
+}
+
+func FuzzBriesemeisterProjectInverse(f *testing.F) {
+	// just short of 180 degrees, near the south pole
+	f.Add(-89.42899999999999, 47.12388980384689)
+	projectInverseDistanceFuzz(f, NewBriesemeister(), nil)
+}
+
+func FuzzStretchedAzimuthalProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewStretchedAzimuthal(NewLambertAzimuthal(), 2))
+}
+
+func FuzzLagrangeGeneralProjectInverse(f *testing.F) {
+	projectInverseFuzz(f, NewLagrangeGeneral(1.5, math.Pi/6))
+}
+
+func FuzzTiltedPerspectiveProjectInverse(f *testing.F) {
+	f.Add(0.4, -0.3)
+	f.Add(1.0, 0.5)
+	projectInverseDistanceFuzz(f, NewTiltedPerspective(0.4, -0.3, 0.8, 0.5, 1.1, math.Pi), skipHidden)
+}
+
+func FuzzTwoPointEquidistantProjectInverse(f *testing.F) {
+	f.Add(0.2, -1.5)
+	f.Add(-0.2, 1.6)
+	projectInverseDistanceFuzz(f, NewTwoPointEquidistant(0.2, -1.5, 0.8, 2.0), nil)
+}
+
+func FuzzChamberlinAfricaProjectInverse(f *testing.F) {
+	f.Add(0.4, 0.4)
+	f.Add(-0.5, 0.8)
+	// away from the control points the projection folds over itself, so only the region it is made for
+	projectInverseDistanceFuzz(f, NewChamberlinAfrica(), func(lat float64, lon float64, x float64, y float64) bool {
+		return math.Abs(lat) > radians(40) || math.Abs(lon-radians(22.5)) > radians(45)
+	})
+}
+
+func FuzzTwoPointAzimuthalProjectInverse(f *testing.F) {
+	f.Add(0.7, -1.3)
+	f.Add(1.0, 0.5)
+	// hidden, or too near the horizon to invert precisely
+	projectInverseDistanceFuzz(f, NewTwoPointAzimuthal(0.7, -1.3, 0.9, 0), func(lat float64, lon float64, x float64, y float64) bool {
+		return skipHidden(lat, lon, x, y) || math.Hypot(x, y) > 1e6
+	})
+}
+
 func withinTolerance(n1, n2, tolerance float64) bool {
 	if n1 == n2 {
 		return true
@@ -151,6 +375,37 @@ func projectInverseFuzz(f *testing.F, proj Projection) {
 	})
 }
 
+// Like projectInverseFuzz, but compares locations by the distance between them, and skips the projected points
+// for which skip returns true. Where 180 degrees crosses the middle of the map, as it does polyhedral nets and
+// oblique aspects, only a few units in the last place of the planar coordinates can separate the two sides of
+// it, so the inverse is free to give either of -Pi and Pi for the same meridian.
+func projectInverseDistanceFuzz(f *testing.F, proj Projection, skip func(lat float64, lon float64, x float64, y float64) bool) {
+	f.Add(0.0, 0.0)
+	f.Add(0.0, math.Pi)
+	f.Add(math.Pi/2, math.Pi/4)
+	f.Add(math.Pi/2, 0.0)
+	f.Add(-math.Pi/2, -math.Pi/4)
+	f.Add(math.Pi/2, math.Pi)
+	f.Add(66.0, 0.0)
+	f.Fuzz(func(t *testing.T, lat float64, lon float64) {
+		lat = math.Mod(lat, math.Pi/2)
+		lon = math.Mod(lon, math.Pi)
+		x, y := proj.Project(lat, lon)
+		if skip != nil && skip(lat, lon, x, y) {
+			return
+		}
+		rlat, rlon := proj.Inverse(x, y)
+		if GreatCircleDistance(lat, lon, rlat, rlon) > 0.000001 {
+			t.Errorf("expected %e,%e (%e, %e), got %e,%e", lat, lon, x, y, rlat, rlon)
+		}
+	})
+}
+
+// Skips the locations which project to NaN, as those hidden from a perspective do.
+func skipHidden(lat float64, lon float64, x float64, y float64) bool {
+	return math.IsNaN(x) || math.IsNaN(y)
+}
+
 /*func FuzzObliqueTransformInverse(f *testing.F) {
 	f.Add(0.0, 0.0, 0.0, math.Pi/4, -math.Pi/4)
 	f.Fuzz(func(t *testing.T, lat float64, lon float64, poleLat float64, poleLon float64, poleTheta float64) {
@@ -32,6 +32,16 @@ func (a Aitoff) PlanarBounds() Bounds {
 	return NewEllipseBounds(math.Pi, math.Pi/2)
 }
 
+func (a Aitoff) Describe() Metadata {
+	return Metadata{
+		Name:     "Aitoff",
+		Family:   FamilyLenticular,
+		Shape:    ShapeEllipse,
+		Extent:   ExtentWorld,
+		PROJName: "aitoff",
+	}
+}
+
 // An elliptical equal-area projection.
 // https://en.wikipedia.org/wiki/Hammer_projection
 type Hammer struct{}
@@ -72,45 +82,288 @@ func (h Hammer) PlanarBounds() Bounds {
 	return NewEllipseBounds(2, 1)
 }
 
-// A circular conformal projection of the whole earth.
-type Lagrange struct{}
+func (h Hammer) Describe() Metadata {
+	return Metadata{
+		Name:       "Hammer",
+		Aliases:    []string{"Hammer–Aitoff"},
+		Family:     FamilyLenticular,
+		Properties: EqualArea,
+		Shape:      ShapeEllipse,
+		Extent:     ExtentWorld,
+		PROJName:   "hammer",
+	}
+}
+
+// Wagner's generalization of the Hammer projection, which projects the part of the sphere between latitudes
+// ±PoleLine and longitudes ±MeridianBound with the equatorial Lambert azimuthal projection, renumbered to cover the
+// whole sphere and stretched to the given ratio of width to height. Areas at latitude 60° are inflated by the
+// given fraction, so a zero inflation keeps the projection equal-area. A pole line below Pi/2 shows the poles as
+// lines, and the parameters (Pi/2, Pi/2, 0, 2) give the Hammer projection, up to scale.
+// https://en.wikipedia.org/wiki/Wagner_VII_projection
+type HammerWagner struct {
+	PoleLine      float64
+	MeridianBound float64
+	Inflation     float64
+	Ratio         float64
+	form          umbeziffern
+}
+
+func NewHammerWagner(poleLine float64, meridianBound float64, inflation float64, ratio float64) HammerWagner {
+	return HammerWagner{
+		PoleLine:      poleLine,
+		MeridianBound: meridianBound,
+		Inflation:     inflation,
+		Ratio:         ratio,
+		form:          newUmbeziffern(poleLine, meridianBound, inflation, ratio),
+	}
+}
+
+// An equal-area projection of the whole sphere built like the Hammer projection from a quarter of the Lambert
+// azimuthal projection rather than half of it, giving a more rounded outline with pointed poles.
+// https://en.wikipedia.org/wiki/Hammer_projection
+func NewEckertGreifendorff() HammerWagner {
+	return NewHammerWagner(math.Pi/2, math.Pi/4, 0, 4*math.Sqrt2*math.Sin(math.Pi/8))
+}
+
+func (h HammerWagner) Project(lat float64, lon float64) (float64, float64) {
+	return h.form.project(lat, lon)
+}
+
+func (h HammerWagner) Inverse(x float64, y float64) (float64, float64) {
+	return h.form.inverse(x, y)
+}
+
+func (h HammerWagner) PlanarBounds() Bounds {
+	return h.form.bounds()
+}
+
+func (h HammerWagner) Describe() Metadata {
+	meta := Metadata{
+		Name:   "Hammer-Wagner",
+		Family: FamilyLenticular,
+		Shape:  ShapeOther,
+		Extent: ExtentWorld,
+	}
+	if h.Inflation == 0 {
+		meta.Properties = EqualArea
+	}
+	switch {
+	case h.PoleLine < math.Pi/2:
+		meta.Shape = ShapeFlatPolar
+	case h.MeridianBound == math.Pi/2:
+		meta.Shape = ShapeEllipse
+	}
+	if h.PoleLine == math.Pi/2 && h.MeridianBound == math.Pi/4 && h.Inflation == 0 {
+		meta.Name = "Eckert-Greifendorff"
+	}
+	return meta
+}
+
+// An oblique equal-area projection of the whole sphere centered on 45°N 10°E, using the Hammer projection
+// stretched to a ratio of 1.75 to 1, which keeps the continents together in one piece.
+// https://en.wikipedia.org/wiki/Briesemeister_projection
+type Briesemeister struct{}
+
+var briesemeisterForm ObliqueProjection = NewObliqueProjectionFromRotation(
+	NewHammerWagner(math.Pi/2, math.Pi/2, 0, 1.75),
+	NewRotationFromCenter(radians(45), radians(10), 0),
+)
+
+func NewBriesemeister() Briesemeister {
+	return Briesemeister{}
+}
 
+func (b Briesemeister) Project(lat float64, lon float64) (float64, float64) {
+	return briesemeisterForm.Project(lat, lon)
+}
+
+func (b Briesemeister) Inverse(x float64, y float64) (float64, float64) {
+	return briesemeisterForm.Inverse(x, y)
+}
+
+func (b Briesemeister) PlanarBounds() Bounds {
+	return briesemeisterForm.PlanarBounds()
+}
+
+func (b Briesemeister) Describe() Metadata {
+	return Metadata{
+		Name:       "Briesemeister",
+		Family:     FamilyLenticular,
+		Properties: EqualArea,
+		Aspect:     AspectOblique,
+		Shape:      ShapeEllipse,
+		Extent:     ExtentWorld,
+	}
+}
+
+// The equatorial aspect of an azimuthal projection with longitudes divided by the given factor, stretched
+// horizontally by the same factor, as Aitoff built his projection from the azimuthal equidistant projection
+// and Hammer from the Lambert azimuthal projection. The azimuthal projection is given in its polar aspect, like
+// those in this package, and a factor of 2 maps the whole sphere into one hemisphere of it.
+// https://en.wikipedia.org/wiki/Aitoff_projection
+type StretchedAzimuthal struct {
+	Azimuthal Projection
+	Factor    float64
+}
+
+// The rotation which moves the center of the equatorial aspect to the north pole of the polar aspect.
+var equatorialAspect Rotation = NewRotationFromPole(0, 0, 0)
+
+func NewStretchedAzimuthal(azimuthal Projection, factor float64) StretchedAzimuthal {
+	return StretchedAzimuthal{
+		Azimuthal: azimuthal,
+		Factor:    factor,
+	}
+}
+
+func (s StretchedAzimuthal) Project(lat float64, lon float64) (float64, float64) {
+	x, y := s.Azimuthal.Project(equatorialAspect.Apply(lat, lon/s.Factor))
+	if s.Factor >= 2 {
+		bounds := s.PlanarBounds().(EllipseBounds)
+		return withinEllipse(x*s.Factor, y, bounds.SemiaxisX, bounds.SemiaxisY)
+	}
+	return x * s.Factor, y
+}
+
+func (s StretchedAzimuthal) Inverse(x float64, y float64) (float64, float64) {
+	lat, lon := equatorialAspect.ApplyInverse(s.Azimuthal.Inverse(x/s.Factor, y))
+	return lat, lon * s.Factor
+}
+
+// The ellipse through the ends of the equator and the poles for a factor of at least 2, which the image of the
+// sphere fills when the factor is 2 and lies within for larger factors. Smaller factors show
+// more than a hemisphere of the azimuthal projection, outlined by the image of the meridians ±Pi.
+func (s StretchedAzimuthal) PlanarBounds() Bounds {
+	if s.Factor < 2 {
+		return NewLuneBounds(s.Azimuthal, s.Factor)
+	}
+	x, _ := s.Azimuthal.Project(equatorialAspect.Apply(0, math.Pi/s.Factor))
+	_, y := s.Azimuthal.Project(equatorialAspect.Apply(math.Pi/2, 0))
+	return NewEllipseBounds(x*s.Factor, y)
+}
+
+// Describes the stretched projection in terms of the azimuthal projection's metadata. Only the equal-area
+// property survives the stretch.
+func (s StretchedAzimuthal) Describe() Metadata {
+	meta := Metadata{Name: "Azimuthal"}
+	if describer, ok := s.Azimuthal.(Describer); ok {
+		meta = describer.Describe()
+	}
+	meta.Name = "Stretched " + meta.Name
+	meta.Aliases = nil
+	meta.Family = FamilyLenticular
+	meta.Properties &= EqualArea
+	if meta.Shape == ShapeCircle && s.Factor >= 2 {
+		meta.Shape = ShapeEllipse
+	} else if meta.Shape == ShapeCircle {
+		meta.Shape = ShapeOther
+	}
+	meta.EPSGMethod = 0
+	meta.PROJName = ""
+	return meta
+}
+
+// A conformal projection which shows the whole sphere between two circular arcs through the poles, by
+// compressing longitudes by the factor W and isometric latitudes about the given parallel before applying the
+// stereographic projection. The default W of 2 fits the world in a circle, while a W of 1 gives the equatorial
+// stereographic projection of the whole plane.
+// https://en.wikipedia.org/wiki/Lagrange_projection
+type Lagrange struct {
+	// The factor by which longitudes are compressed, at least 1. The meridians ±Pi are drawn as arcs which
+	// meet the poles at an angle of 2Pi/W. Zero is taken as the default of 2, so that Lagrange{} is the
+	// classic projection.
+	W float64
+	// The latitude (in radians) which is shown as a straight line through the center.
+	Parallel float64
+}
+
+// The Lagrange projection of the world in a circle, with a straight equator.
 func NewLagrange() Lagrange {
-	return Lagrange{}
+	return NewLagrangeGeneral(2, 0)
+}
+
+func NewLagrangeGeneral(w float64, parallel float64) Lagrange {
+	if w < 1 {
+		panic("W must be at least 1 in Lagrange projection")
+	}
+	if math.Abs(parallel) >= math.Pi/2 {
+		panic("the straight parallel of a Lagrange projection cannot be a pole")
+	}
+	return Lagrange{W: w, Parallel: parallel}
 }
 
 func (l Lagrange) Project(lat float64, lon float64) (float64, float64) {
-	p := (1 + math.Sin(lat)) / (1 - math.Sin(lat))
-	v := math.Pow(p, 0.25)
-	c := (v+1/v)/2 + math.Cos(lon/2)
-	if math.IsInf(c, 0) {
-		if math.Signbit(lat) {
-			return 0, -1
-		} else {
-			return 0, 1
-		}
+	psi := math.Asinh(math.Tan(lat))
+	if math.Abs(lat) == math.Pi/2 {
+		psi = math.Inf(int(math.Copysign(1, lat)))
+	}
+	// divided through by cosh(t), so that the poles map to (0, ±1)
+	w := l.factor()
+	t := (psi - math.Asinh(math.Tan(l.Parallel))) / w
+	sinLon, cosLon := math.Sincos(lon / w)
+	sech := 1 / math.Cosh(t)
+	c := 1 + cosLon*sech
+	x, y := sinLon*sech/c, math.Tanh(t)/c
+	if w == 2 {
+		return withinCircle(x, y, 1)
 	}
-	x := math.Sin(lon/2) / c
-	y := (v - 1/v) / (2 * c)
 	return x, y
 }
 
 func (l Lagrange) Inverse(x float64, y float64) (float64, float64) {
 	r2 := x*x + y*y
-	th := 2 * y / (1 + r2)
-	if th == 1 {
-		if math.Signbit(y) {
-			return -math.Pi / 2, 0
-		} else {
-			return math.Pi / 2, 0
-		}
-	}
-	t := math.Pow((1+th)/(1-th), 2)
-	lat := math.Asin((t - 1) / (t + 1))
-	lon := 2 * math.Atan2(2*x, 1-r2)
+	t := math.Atanh(math.Max(-1, math.Min(1, 2*y/(1+r2))))
+	w := l.factor()
+	lat := math.Atan(math.Sinh(w*t + math.Asinh(math.Tan(l.Parallel))))
+	lon := w * math.Atan2(2*x, 1-r2)
 	return lat, lon
 }
 
+// The compression of longitudes, W, or the default of 2 for the zero value.
+func (l Lagrange) factor() float64 {
+	if l.W == 0 {
+		return 2
+	}
+	return l.W
+}
+
+// The meridians ±Pi are arcs of circles through the poles at (0, ±1), which form the unit circle when W is 2, or
+// bulge beyond it when W is smaller.
 func (l Lagrange) PlanarBounds() Bounds {
-	return NewCircleBounds(1)
+	w := l.factor()
+	if w == 2 {
+		return NewCircleBounds(1)
+	}
+	if w == 1 {
+		return RectangleBounds{
+			XMin: math.Inf(-1),
+			YMin: math.Inf(-1),
+			XMax: math.Inf(1),
+			YMax: math.Inf(1),
+		}
+	}
+	sinEdge, cosEdge := math.Sincos(math.Pi / w)
+	height := 1.0
+	if w < 2 {
+		height = 1 / sinEdge
+	}
+	return NewRectangleBounds(2*sinEdge/(1+cosEdge), 2*height)
+}
+
+func (l Lagrange) Describe() Metadata {
+	shape := ShapeOther
+	switch l.factor() {
+	case 1:
+		shape = ShapeUnbounded
+	case 2:
+		shape = ShapeCircle
+	}
+	return Metadata{
+		Name:       "Lagrange",
+		Family:     FamilyLenticular,
+		Properties: Conformal,
+		Shape:      shape,
+		Extent:     ExtentWorld,
+		PROJName:   "lagrng",
+	}
 }
@@ -0,0 +1,256 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStretchedAzimuthalMatchesAitoffAndHammer(t *testing.T) {
+	aitoff, stretchedPolar := NewAitoff(), NewStretchedAzimuthal(NewPolar(), 2)
+	hammer, stretchedLambert := NewHammer(), NewStretchedAzimuthal(NewLambertAzimuthal(), 2)
+	for lat := -85.0; lat < 86; lat += 8.5 {
+		for lon := -175.0; lon < 176; lon += 17.5 {
+			rlat, rlon := lat*math.Pi/180, lon*math.Pi/180
+			ax, ay := aitoff.Project(rlat, rlon)
+			sx, sy := stretchedPolar.Project(rlat, rlon)
+			if !withinTolerance(ax, sx, 1e-12) || !withinTolerance(ay, sy, 1e-12) {
+				t.Errorf("expected stretched azimuthal equidistant to match Aitoff at %f,%f, got %f,%f against %f,%f", lat, lon, sx, sy, ax, ay)
+			}
+			hx, hy := hammer.Project(rlat, rlon)
+			sx, sy = stretchedLambert.Project(rlat, rlon)
+			if !withinTolerance(hx, sx*math.Sqrt2, 1e-12) || !withinTolerance(hy, sy*math.Sqrt2, 1e-12) {
+				t.Errorf("expected stretched Lambert azimuthal to match Hammer at %f,%f, got %f,%f against %f,%f", lat, lon, sx, sy, hx, hy)
+			}
+		}
+	}
+}
+
+func TestHammerWagnerMatchesPresets(t *testing.T) {
+	testCases := []struct {
+		name   string
+		proj   Projection
+		preset Projection
+		scale  float64
+	}{
+		{"Hammer", NewHammerWagner(math.Pi/2, math.Pi/2, 0, 2), NewHammer(), math.Sqrt2},
+		{"WagnerVII", NewHammerWagner(radians(65), radians(60), 0, 2), NewWagnerVII(), 1},
+		{"WagnerVIII", NewHammerWagner(radians(65), radians(60), 0.2, 2), NewWagnerVIII(), 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for lat := -90.0; lat <= 90; lat += 7.5 {
+				for lon := -180.0; lon <= 180; lon += 15 {
+					x, y := tc.proj.Project(lat*math.Pi/180, lon*math.Pi/180)
+					px, py := tc.preset.Project(lat*math.Pi/180, lon*math.Pi/180)
+					if !withinTolerance(x, px*tc.scale, 1e-12) || !withinTolerance(y, py*tc.scale, 1e-12) {
+						t.Errorf("expected %f,%f to project to %f,%f, got %f,%f", lat, lon, px*tc.scale, py*tc.scale, x, y)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestLenticularEqualArea(t *testing.T) {
+	projections := []Projection{
+		NewEckertGreifendorff(), NewBriesemeister(), NewHammerWagner(radians(75), radians(70), 0, 1.8),
+		NewStretchedAzimuthal(NewLambertAzimuthal(), 3),
+	}
+	reference := make([]float64, len(projections))
+	for i, proj := range projections {
+		reference[i] = AreaDistortionAt(proj, 0.1, 0.1)
+	}
+	for i, proj := range projections {
+		for lat := -85.0; lat < 86; lat += 8.5 {
+			for lon := -175.0; lon < 176; lon += 17.5 {
+				if area := AreaDistortionAt(proj, lat*math.Pi/180, lon*math.Pi/180); !withinTolerance(area, reference[i], 0.00001) {
+					t.Errorf("expected %T to be equal-area at %f,%f, got area distortion %e against %e", proj, lat, lon, area, reference[i])
+				}
+			}
+		}
+	}
+}
+
+func TestLenticularLandmarks(t *testing.T) {
+	eckertGreifendorffEquator := 4 * math.Sqrt(2/(1+math.Cos(math.Pi/4))) * math.Sin(math.Pi/4)
+	testCases := []struct {
+		name     string
+		proj     Projection
+		lat, lon float64
+		x, y     float64
+	}{
+		{"EckertGreifendorffEquator", NewEckertGreifendorff(), 0, 180, eckertGreifendorffEquator, 0},
+		{"EckertGreifendorffNorthPole", NewEckertGreifendorff(), 90, 0, 0, math.Sqrt2},
+		{"BriesemeisterCenter", NewBriesemeister(), 45, 10, 0, 0},
+		{"BriesemeisterAntipode", NewBriesemeister(), -45, -170, math.Sqrt(7), 0},
+		{"StretchedOrthographicEdge", NewStretchedAzimuthal(NewOrthographic(), 2), 0, 180, 2, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			x, y := tc.proj.Project(tc.lat*math.Pi/180, tc.lon*math.Pi/180)
+			if !withinTolerance(math.Abs(x), tc.x, 1e-9) || !withinTolerance(y, tc.y, 1e-9) {
+				t.Errorf("expected %f,%f to project to %f,%f, got %f,%f", tc.lat, tc.lon, tc.x, tc.y, x, y)
+			}
+		})
+	}
+}
+
+func TestBriesemeisterNorthUp(t *testing.T) {
+	proj := NewBriesemeister()
+	x, y := proj.Project(math.Pi/2, 0)
+	if !withinTolerance(x, 0, 1e-12) || y <= 0 {
+		t.Errorf("expected the north pole straight above the center, got %f,%f", x, y)
+	}
+}
+
+func TestLagrangeConformal(t *testing.T) {
+	projections := []Lagrange{
+		NewLagrange(), NewLagrangeGeneral(2, math.Pi/6), NewLagrangeGeneral(1.4, -0.3), NewLagrangeGeneral(3, 1.2),
+	}
+	for _, proj := range projections {
+		for lat := -80.0; lat < 81; lat += 8 {
+			for lon := -170.0; lon < 171; lon += 17 {
+				if angle := AngularDistortionAt(proj, lat*math.Pi/180, lon*math.Pi/180); !withinTolerance(angle, 0, 1e-5) {
+					t.Errorf("expected %v to be conformal at %f,%f, got angular distortion %e", proj, lat, lon, angle)
+				}
+			}
+		}
+	}
+}
+
+func TestLagrangeStraightParallel(t *testing.T) {
+	for _, proj := range []Lagrange{NewLagrange(), NewLagrangeGeneral(2, math.Pi/6), NewLagrangeGeneral(1.4, -0.3), NewLagrangeGeneral(3, 1.2)} {
+		for lon := -180.0; lon <= 180; lon += 15 {
+			if _, y := proj.Project(proj.Parallel, lon*math.Pi/180); !withinTolerance(y, 0, 1e-15) {
+				t.Errorf("expected the parallel of %v to be straight, got y %e at longitude %f", proj, y, lon)
+			}
+		}
+		if x, y := proj.Project(math.Pi/2, 1); x != 0 || y != 1 {
+			t.Errorf("expected the north pole of %v at 0,1, got %f,%f", proj, x, y)
+		}
+	}
+}
+
+func TestLagrangeStereographic(t *testing.T) {
+	proj := NewLagrangeGeneral(1, 0)
+	for lat := -80.0; lat < 81; lat += 8 {
+		for lon := -170.0; lon < 171; lon += 17 {
+			rlat, rlon := lat*math.Pi/180, lon*math.Pi/180
+			k := 1 + math.Cos(rlat)*math.Cos(rlon)
+			x, y := proj.Project(rlat, rlon)
+			if !withinTolerance(x, math.Cos(rlat)*math.Sin(rlon)/k, 1e-12) || !withinTolerance(y, math.Sin(rlat)/k, 1e-12) {
+				t.Errorf("expected the equatorial stereographic projection at %f,%f, got %f,%f", lat, lon, x, y)
+			}
+		}
+	}
+}
+
+func TestLagrangeBounds(t *testing.T) {
+	testCases := []struct {
+		name          string
+		proj          Lagrange
+		width, height float64
+	}{
+		{"Circle", NewLagrange(), 2, 2},
+		{"Narrow", NewLagrangeGeneral(3, 0), 2 * math.Tan(math.Pi/6), 2},
+		{"Wide", NewLagrangeGeneral(1.5, 0), 2 * math.Tan(math.Pi/3), 2 / math.Sin(2*math.Pi/3)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bounds := tc.proj.PlanarBounds()
+			if !withinTolerance(bounds.Width(), tc.width, 1e-12) || !withinTolerance(bounds.Height(), tc.height, 1e-12) {
+				t.Errorf("expected bounds %f by %f, got %f by %f", tc.width, tc.height, bounds.Width(), bounds.Height())
+			}
+		})
+	}
+}
+
+func TestLagrangeZeroValue(t *testing.T) {
+	for lat := -80.0; lat < 81; lat += 16 {
+		for lon := -170.0; lon < 171; lon += 34 {
+			x, y := Lagrange{}.Project(lat*math.Pi/180, lon*math.Pi/180)
+			ex, ey := NewLagrange().Project(lat*math.Pi/180, lon*math.Pi/180)
+			if x != ex || y != ey {
+				t.Errorf("expected the zero value to match NewLagrange at %f,%f, got %f,%f rather than %f,%f", lat, lon, x, y, ex, ey)
+			}
+			if rlat, rlon := (Lagrange{}).Inverse(x, y); !withinTolerance(rlat, lat*math.Pi/180, 1e-9) || !withinTolerance(rlon, lon*math.Pi/180, 1e-9) {
+				t.Errorf("expected the zero value to invert %f,%f, got %f,%f", lat, lon, rlat, rlon)
+			}
+		}
+	}
+	if _, ok := (Lagrange{}).PlanarBounds().(CircleBounds); !ok {
+		t.Errorf("expected the zero value to fit in a circle")
+	}
+}
+
+func TestHammerWagnerEllipseBounds(t *testing.T) {
+	for _, proj := range []Projection{NewHammerWagner(math.Pi/2, math.Pi/2, 0, 2), NewBriesemeister()} {
+		bounds, ok := proj.PlanarBounds().(EllipseBounds)
+		if !ok {
+			t.Fatalf("expected %v to fit in an ellipse, got %v", proj, proj.PlanarBounds())
+		}
+		if bounds.Within(0.95*bounds.SemiaxisX, 0.95*bounds.SemiaxisY) {
+			t.Errorf("expected the corner of the rectangle around %v to be outside its ellipse", proj)
+		}
+		if lat, lon := proj.Inverse(1.5*bounds.SemiaxisX, 0); !math.IsNaN(lat) || !math.IsNaN(lon) {
+			t.Errorf("expected no inverse beyond the image of the sphere for %v, got %f,%f", proj, lat, lon)
+		}
+	}
+}
+
+func TestStretchedAzimuthalBounds(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		proj                 StretchedAzimuthal
+		semiaxisX, semiaxisY float64
+	}{
+		{"Aitoff", NewStretchedAzimuthal(NewPolar(), 2), math.Pi, math.Pi / 2},
+		{"Hammer", NewStretchedAzimuthal(NewLambertAzimuthal(), 2), math.Sqrt2, math.Sqrt2 / 2},
+		{"EckertGreifendorff", NewStretchedAzimuthal(NewLambertAzimuthal(), 4), 4 * math.Sin(math.Pi/8), math.Sqrt2 / 2},
+		{"Orthographic", NewStretchedAzimuthal(NewOrthographic(), 3), 3 * math.Sin(math.Pi/3), 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bounds, ok := tc.proj.PlanarBounds().(EllipseBounds)
+			if !ok {
+				t.Fatalf("expected an ellipse, got %v", tc.proj.PlanarBounds())
+			}
+			if !withinTolerance(bounds.SemiaxisX, tc.semiaxisX, 1e-12) || !withinTolerance(bounds.SemiaxisY, tc.semiaxisY, 1e-12) {
+				t.Errorf("expected semiaxes %f and %f, got %f and %f", tc.semiaxisX, tc.semiaxisY, bounds.SemiaxisX, bounds.SemiaxisY)
+			}
+		})
+	}
+}
+
+func TestStretchedAzimuthalLuneBounds(t *testing.T) {
+	proj := NewStretchedAzimuthal(NewPolar(), 1.5)
+	bounds, ok := proj.PlanarBounds().(LuneBounds)
+	if !ok {
+		t.Fatalf("expected a lune for a factor below 2, got %v", proj.PlanarBounds())
+	}
+	// the outermost meridian rises above the pole before turning back to it
+	if _, y := proj.Project(math.Pi/3, math.Pi); bounds.SemiaxisY <= math.Pi/2 || bounds.SemiaxisY < y {
+		t.Errorf("expected the outermost meridian to set the height, got %f below %f", bounds.SemiaxisY, y)
+	}
+	if x, _ := proj.Project(0, math.Pi); !withinTolerance(bounds.SemiaxisX, x, 1e-12) {
+		t.Errorf("expected the ends of the equator to set the width, got %f rather than %f", bounds.SemiaxisX, x)
+	}
+	for lat := -90.0; lat <= 90; lat += 7.5 {
+		for _, lon := range []float64{-180, -120, 0, 60, 180} {
+			if x, y := proj.Project(lat*math.Pi/180, lon*math.Pi/180); !bounds.Within(x, y) {
+				t.Errorf("expected %f,%f (projected to %f,%f) within the lune", lat, lon, x, y)
+			}
+		}
+	}
+	// just beyond the outermost meridian on the equator, and inside the circle of the azimuthal projection
+	if x, _ := proj.Project(0, math.Pi); bounds.Within(x*1.01, 0) || !NewPolar().PlanarBounds().Within(x*1.01/1.5, 0) {
+		t.Errorf("expected a point beyond the outermost meridian outside the lune")
+	}
+	if x, _ := proj.Project(0, math.Pi); bounds.Within(x/2, bounds.SemiaxisY*0.99) {
+		t.Errorf("expected the corner of the extent outside the lune")
+	}
+}
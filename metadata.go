@@ -0,0 +1,184 @@
+package flatsphere
+
+import "strings"
+
+// A projection able to report descriptive information about itself, such as its family and the
+// properties of the sphere it preserves. All projections in this package implement Describer.
+type Describer interface {
+	Describe() Metadata
+}
+
+// Descriptive information about a projection, useful for classifying and choosing between projections.
+type Metadata struct {
+	Name       string     // The common name of the projection.
+	Aliases    []string   // Other names the projection, or an equivalent projection, is known by.
+	Family     Family     // The geometric construction the projection belongs to.
+	Aspect     Aspect     // The orientation of the projection relative to the poles.
+	Properties Properties // The properties of the sphere preserved by the projection.
+	Shape      Shape      // The natural outline of the whole sphere in the projection plane.
+	Extent     Extent     // The size of region the projection is best suited to mapping.
+	EPSGMethod int        // The EPSG coordinate operation method code, or 0 if there is none.
+	PROJName   string     // The PROJ projection identifier (as in +proj=name), or empty if there is none.
+}
+
+// Determines whether the projection has all of the given properties.
+func (m Metadata) Has(p Properties) bool {
+	return m.Properties&p == p
+}
+
+// Determines whether the projection is a compromise projection, which is neither conformal nor equal-area.
+func (m Metadata) IsCompromise() bool {
+	return m.Properties&(Conformal|EqualArea) == 0
+}
+
+// Determines whether the projection is known by the given name, ignoring case.
+func (m Metadata) KnownAs(name string) bool {
+	if strings.EqualFold(m.Name, name) {
+		return true
+	}
+	for _, alias := range m.Aliases {
+		if strings.EqualFold(alias, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// The geometric construction a projection belongs to.
+type Family int
+
+const (
+	FamilyOther Family = iota
+	FamilyCylindrical
+	FamilyPseudocylindrical
+	FamilyConic
+	FamilyAzimuthal
+	FamilyLenticular
+	FamilyPolyhedral
+)
+
+func (f Family) String() string {
+	switch f {
+	case FamilyCylindrical:
+		return "cylindrical"
+	case FamilyPseudocylindrical:
+		return "pseudocylindrical"
+	case FamilyConic:
+		return "conic"
+	case FamilyAzimuthal:
+		return "azimuthal"
+	case FamilyLenticular:
+		return "lenticular"
+	case FamilyPolyhedral:
+		return "polyhedral"
+	default:
+		return "other"
+	}
+}
+
+// The orientation of a projection relative to the poles of the sphere.
+type Aspect int
+
+const (
+	AspectNormal     Aspect = iota // The standard orientation of the projection.
+	AspectTransverse               // The projection rotated so that its poles lie on the equator.
+	AspectOblique                  // The projection rotated by any other amount.
+)
+
+func (a Aspect) String() string {
+	switch a {
+	case AspectTransverse:
+		return "transverse"
+	case AspectOblique:
+		return "oblique"
+	default:
+		return "normal"
+	}
+}
+
+// A set of properties of the sphere which a projection preserves.
+type Properties uint
+
+const (
+	Conformal            Properties = 1 << iota // Angles are preserved locally.
+	EqualArea                                   // Relative areas are preserved everywhere.
+	Equidistant                                 // Distances are true along some set of lines, or from some point.
+	Azimuthal                                   // Directions from the center of the projection are true.
+	StraightGreatCircles                        // All great circles are straight lines.
+	StraightRhumbLines                          // All rhumb lines (loxodromes) are straight lines.
+)
+
+func (p Properties) String() string {
+	names := []string{}
+	for _, prop := range []struct {
+		flag Properties
+		name string
+	}{
+		{Conformal, "conformal"},
+		{EqualArea, "equal-area"},
+		{Equidistant, "equidistant"},
+		{Azimuthal, "azimuthal"},
+		{StraightGreatCircles, "straight great circles"},
+		{StraightRhumbLines, "straight rhumb lines"},
+	} {
+		if p&prop.flag != 0 {
+			names = append(names, prop.name)
+		}
+	}
+	if len(names) == 0 {
+		return "compromise"
+	}
+	return strings.Join(names, ", ")
+}
+
+// The natural outline of the whole sphere in a projection plane.
+type Shape int
+
+const (
+	ShapeOther        Shape = iota
+	ShapeRectangle          // A rectangle, which may be unbounded vertically.
+	ShapeCircle             // A circle or disc.
+	ShapeEllipse            // An ellipse.
+	ShapeFlatPolar          // An oval with the poles represented as lines.
+	ShapePointedPolar       // An outline narrowing to the poles as points.
+	ShapeUnbounded          // The sphere cannot be shown in its entirety.
+)
+
+func (s Shape) String() string {
+	switch s {
+	case ShapeRectangle:
+		return "rectangle"
+	case ShapeCircle:
+		return "circle"
+	case ShapeEllipse:
+		return "ellipse"
+	case ShapeFlatPolar:
+		return "flat-polar"
+	case ShapePointedPolar:
+		return "pointed-polar"
+	case ShapeUnbounded:
+		return "unbounded"
+	default:
+		return "other"
+	}
+}
+
+// The size of region a projection is best suited to mapping.
+type Extent int
+
+const (
+	ExtentWorld      Extent = iota // The whole sphere.
+	ExtentHemisphere               // Around half of the sphere.
+	ExtentRegional                 // A continent or smaller region.
+)
+
+func (e Extent) String() string {
+	switch e {
+	case ExtentHemisphere:
+		return "hemisphere"
+	case ExtentRegional:
+		return "regional"
+	default:
+		return "world"
+	}
+}
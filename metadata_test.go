@@ -0,0 +1,100 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBuiltinProjectionsDescribe(t *testing.T) {
+	projections := []Projection{
+		NewMercator(), NewPlateCarree(), NewEquirectangular(math.Pi / 6), NewCylindricalEqualArea(math.Pi / 8),
+		NewLambertCylindrical(), NewBehrmann(), NewGallOrthographic(), NewHoboDyer(), NewGallStereographic(),
+		NewMiller(), NewCentral(), NewCassini(), NewSinusoidal(), NewMollweide(), NewHomolosine(), NewEckertIV(),
+		NewEqualEarth(), NewLoximuthal(math.Pi / 4), NewAlbers(0.5, 0.8), NewLambertConformalConic(0.5, 0.8),
+		NewStereographic(), NewPolar(), NewLambertAzimuthal(), NewGnomonic(), NewOrthographic(), NewVerticalPerspective(3), NewObliqueVerticalPerspective(1, 1, 3), NewTiltedPerspective(1, 1, 2, 0.3, 0.5, math.Pi/2),
+		NewAitoff(), NewHammer(), NewLagrange(), NewLagrangeGeneral(1.5, 0.3), NewHEALPixStandard(), NewRobinson(), NewNaturalEarth(),
+		NewFisheyeEquidistant(1, math.Pi), NewFisheyeEquisolid(1, math.Pi), NewFisheyeOrthographic(1, math.Pi),
+		NewFisheyeStereographic(1, math.Pi), NewKannalaBrandt(1, math.Pi, 0, 0, 0, 0),
+		NewTransverseMercator(0, 0, 1), NewUTM(31, false), NewUPS(true),
+		NewSnyderIcosahedral(), NewQuadrilateralizedCube(),
+		NewPeirceQuincuncial(), NewGuyou(), NewAdamsHemisphereInASquare(), NewAdamsWorldInASquareI(), NewAdamsWorldInASquareII(),
+		NewLeeTetrahedral(), NewLeeTetrahedralRectangle(), NewConformalIcosahedral(),
+		NewVanDerGrintenI(), NewVanDerGrintenII(), NewVanDerGrintenIII(), NewVanDerGrintenIV(),
+		NewNicolosiGlobular(), NewApianGlobular(), NewBaconGlobular(), NewOrteliusOval(),
+		NewEckertI(), NewEckertII(), NewEckertIII(), NewEckertV(), NewEckertVI(),
+		NewWagnerI(), NewWagnerII(), NewWagnerIII(0), NewWagnerIV(), NewWagnerV(), NewWagnerVI(), NewWagnerVII(), NewWagnerVIII(), NewWagnerIX(),
+		NewHammerWagner(math.Pi/3, math.Pi/2, 0, 2), NewEckertGreifendorff(), NewBriesemeister(), NewStretchedAzimuthal(NewPolar(), 2),
+		NewTwoPointEquidistant(0.2, -1.5, 0.8, 2.0), NewChamberlinAfrica(), NewTwoPointAzimuthal(0.7, -1.3, 0.9, 0),
+		NewObliqueProjection(NewMercator(), 0, math.Pi/2, -math.Pi/2),
+	}
+
+	for _, proj := range projections {
+		describer, ok := proj.(Describer)
+		if !ok {
+			t.Errorf("projection %T does not implement Describer", proj)
+			continue
+		}
+		if describer.Describe().Name == "" {
+			t.Errorf("projection %T has no name", proj)
+		}
+	}
+}
+
+func TestMetadataProperties(t *testing.T) {
+	testCases := []struct {
+		name       string
+		proj       Describer
+		family     Family
+		conformal  bool
+		equalArea  bool
+		compromise bool
+	}{
+		{"Mercator", NewMercator(), FamilyCylindrical, true, false, false},
+		{"Mollweide", NewMollweide(), FamilyPseudocylindrical, false, true, false},
+		{"Robinson", NewRobinson(), FamilyPseudocylindrical, false, false, true},
+		{"Stereographic", NewStereographic(), FamilyAzimuthal, true, false, false},
+		{"Hammer", NewHammer(), FamilyLenticular, false, true, false},
+		{"Behrmann", NewBehrmann(), FamilyCylindrical, false, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			meta := tc.proj.Describe()
+			if meta.Family != tc.family {
+				t.Errorf("expected family %v, got %v", tc.family, meta.Family)
+			}
+			if meta.Has(Conformal) != tc.conformal || meta.Has(EqualArea) != tc.equalArea || meta.IsCompromise() != tc.compromise {
+				t.Errorf("unexpected properties %v", meta.Properties)
+			}
+		})
+	}
+}
+
+func TestObliqueMetadata(t *testing.T) {
+	transverse := NewObliqueProjection(NewMercator(), 0, math.Pi/2, -math.Pi/2).Describe()
+	if transverse.Name != "Transverse Mercator" || transverse.Aspect != AspectTransverse {
+		t.Errorf("expected transverse Mercator, got %s with aspect %v", transverse.Name, transverse.Aspect)
+	}
+	if !transverse.Has(Conformal) || transverse.Has(StraightRhumbLines) {
+		t.Errorf("expected transverse Mercator to be conformal without straight rhumb lines, got %v", transverse.Properties)
+	}
+
+	oblique := NewObliqueProjection(NewMollweide(), math.Pi/4, 0, 0).Describe()
+	if oblique.Aspect != AspectOblique || !oblique.Has(EqualArea) || oblique.PROJName != "ob_tran" {
+		t.Errorf("expected oblique equal-area metadata, got %+v", oblique)
+	}
+
+	shifted := NewObliqueProjection(NewMollweide(), math.Pi/2, 1, 0).Describe()
+	if shifted.Aspect != AspectNormal || shifted.PROJName != "moll" {
+		t.Errorf("expected longitude shift to keep normal aspect, got %+v", shifted)
+	}
+}
+
+func TestMetadataKnownAs(t *testing.T) {
+	if !NewGallOrthographic().Describe().KnownAs("peters") {
+		t.Errorf("expected Gall orthographic to be known as Peters")
+	}
+	if NewMercator().Describe().KnownAs("Mollweide") {
+		t.Errorf("expected Mercator not to be known as Mollweide")
+	}
+}
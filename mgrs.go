@@ -0,0 +1,222 @@
+package flatsphere
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// The column letters of the 100 kilometer squares of the UTM zones, in sets which repeat every three zones,
+// starting at an easting of 100 kilometers.
+var mgrsColumnLetters = [3]string{"ABCDEFGH", "JKLMNPQR", "STUVWXYZ"}
+
+// The row letters of the 100 kilometer squares of the UTM zones, which repeat every 2000 kilometers of
+// northing, and are offset by five letters in even numbered zones.
+const mgrsRowLetters = "ABCDEFGHJKLMNPQRSTUV"
+
+// The column letters of the 100 kilometer squares of the UPS areas, west then east of the pole, for the south
+// and north, starting at an easting of 800 kilometers in the south and 1300 kilometers in the north.
+var mgrsPolarColumnLetters = [2][2]string{{"JKLPQRSTUXYZ", "ABCFGHJKLPQR"}, {"RSTUXYZ", "ABCFGHJ"}}
+
+// The row letters of the 100 kilometer squares of the UPS areas, starting at a northing of 800 kilometers
+// in the south and 1300 kilometers in the north.
+var mgrsPolarRowLetters = [2]string{"ABCDEFGHJKLMNPQRSTUVWXYZ", "ABCDEFGHJKLMNP"}
+
+const (
+	mgrsSquareSize   = 100000
+	mgrsMaxPrecision = 5
+)
+
+// The first column and row of the polar 100 kilometer squares, in units of the square size, for the south and
+// north.
+var mgrsPolarColumnStart = [2]int{8, 13}
+var mgrsPolarRowStart = [2]int{8, 13}
+
+// Format a location (in radians) on the WGS84 ellipsoid as a Military Grid Reference System (MGRS)
+// reference such as "31NAA6602100000", with the given number of digits (from 0 to 5) for each of the
+// easting and northing within the 100 kilometer square, giving a precision of 100 kilometers down to one
+// meter. Coordinates are truncated rather than rounded, so the reference names the square containing the
+// location. Locations beyond the UTM latitude limits use the polar UPS grid.
+// https://en.wikipedia.org/wiki/Military_Grid_Reference_System
+func FormatMGRS(lat float64, lon float64, precision int) (string, error) {
+	parts, err := mgrsParts(lat, lon, precision)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(parts, ""), nil
+}
+
+// Format a location (in radians) on the WGS84 ellipsoid as a United States National Grid (USNG) reference
+// such as "31N AA 66021 00000", which is an MGRS reference with spaces between its parts. See FormatMGRS.
+// https://en.wikipedia.org/wiki/United_States_National_Grid
+func FormatUSNG(lat float64, lon float64, precision int) (string, error) {
+	parts, err := mgrsParts(lat, lon, precision)
+	if err != nil {
+		return "", err
+	}
+	nonEmpty := parts[:0]
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, " "), nil
+}
+
+// The grid zone designation, 100 kilometer square letters, easting digits and northing digits of the MGRS
+// reference of a location, where the digits may be empty.
+func mgrsParts(lat float64, lon float64, precision int) ([]string, error) {
+	if precision < 0 || precision > mgrsMaxPrecision {
+		return nil, fmt.Errorf("mgrs: precision %d is not between 0 and %d", precision, mgrsMaxPrecision)
+	}
+	if math.IsNaN(lat) || math.IsNaN(lon) || math.Abs(lat) > math.Pi/2 {
+		return nil, fmt.Errorf("mgrs: invalid location (%v, %v)", lat, lon)
+	}
+
+	var zone string
+	var column, row byte
+	var easting, northing float64
+	zoneNumber, band := UTMZone(lat, lon)
+	if band != 0 {
+		easting, northing = mgrsSnap(NewUTM(zoneNumber, lat < 0).Project(lat, lon))
+		columnIndex := min(max(int(math.Floor(easting/mgrsSquareSize)), 1), 8)
+		rowIndex := int(math.Floor(northing/mgrsSquareSize)) + (zoneNumber-1)%2*5
+		zone = fmt.Sprintf("%d%c", zoneNumber, band)
+		column = mgrsColumnLetters[(zoneNumber-1)%3][columnIndex-1]
+		row = mgrsRowLetters[rowIndex%len(mgrsRowLetters)]
+	} else {
+		hemisphere := 0
+		if lat > 0 {
+			hemisphere = 1
+		}
+		easting, northing = mgrsSnap(NewUPS(lat < 0).Project(lat, lon))
+		columnIndex := int(math.Floor(easting/mgrsSquareSize)) - mgrsPolarColumnStart[hemisphere]
+		rowIndex := int(math.Floor(northing/mgrsSquareSize)) - mgrsPolarRowStart[hemisphere]
+		side := 0
+		if easting >= upsFalseOrigin {
+			side = 1
+			columnIndex -= len(mgrsPolarColumnLetters[hemisphere][0])
+		}
+		columns, rows := mgrsPolarColumnLetters[hemisphere][side], mgrsPolarRowLetters[hemisphere]
+		if columnIndex < 0 || columnIndex >= len(columns) || rowIndex < 0 || rowIndex >= len(rows) {
+			return nil, fmt.Errorf("mgrs: location (%v, %v) is outside of the polar grid", lat, lon)
+		}
+		zone = string([]byte{"ABYZ"[2*hemisphere+side]})
+		column, row = columns[columnIndex], rows[rowIndex]
+	}
+
+	digits := func(coord float64) string {
+		if precision == 0 {
+			return ""
+		}
+		scale := math.Pow10(mgrsMaxPrecision - precision)
+		within := math.Mod(coord, mgrsSquareSize)
+		return fmt.Sprintf("%0*d", precision, int(math.Floor(within/scale)))
+	}
+	return []string{zone, string([]byte{column, row}), digits(easting), digits(northing)}, nil
+}
+
+// Round projected coordinates to a micrometer, so that truncating them isn't upset by rounding error, such as
+// the pole landing a hair short of the UPS origin.
+func mgrsSnap(easting float64, northing float64) (float64, float64) {
+	return math.Round(easting*1e6) / 1e6, math.Round(northing*1e6) / 1e6
+}
+
+// Parse an MGRS or USNG reference, such as "31NAA6602100000" or "31N AA 66021 00000", into the location
+// (in radians) on the WGS84 ellipsoid of the southwest corner of the square it names, and the number of
+// digits given for each of the easting and northing. Letters may be in either case, and a reference of
+// just the grid zone designation, such as "31N", is not accepted.
+func ParseMGRS(ref string) (lat float64, lon float64, precision int, err error) {
+	s := strings.ToUpper(strings.Join(strings.Fields(ref), ""))
+	invalid := func(reason string) (float64, float64, int, error) {
+		return 0, 0, 0, fmt.Errorf("mgrs: invalid reference %q: %s", ref, reason)
+	}
+
+	// the zone number, absent for the polar areas
+	i := 0
+	for i < len(s) && i < 2 && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	zoneNumber := 0
+	for _, c := range s[:i] {
+		zoneNumber = 10*zoneNumber + int(c-'0')
+	}
+	if i > 0 && (zoneNumber < 1 || zoneNumber > 60) {
+		return invalid("zone number out of range")
+	}
+	if len(s) < i+3 {
+		return invalid("missing grid zone or 100 kilometer square letters")
+	}
+	band, column, row := s[i], s[i+1], s[i+2]
+	digits := s[i+3:]
+	if len(digits)%2 != 0 || len(digits) > 2*mgrsMaxPrecision {
+		return invalid("easting and northing must have the same number of digits, at most 5")
+	}
+	precision = len(digits) / 2
+	scale := math.Pow10(mgrsMaxPrecision - precision)
+	var eastingOffset, northingOffset float64
+	for j, c := range digits {
+		if c < '0' || c > '9' {
+			return invalid("easting and northing must be digits")
+		}
+		if j < precision {
+			eastingOffset = 10*eastingOffset + float64(c-'0')
+		} else {
+			northingOffset = 10*northingOffset + float64(c-'0')
+		}
+	}
+	eastingOffset *= scale
+	northingOffset *= scale
+
+	if i == 0 {
+		hemisphere, side := 0, 0
+		switch band {
+		case 'A':
+		case 'B':
+			side = 1
+		case 'Y':
+			hemisphere = 1
+		case 'Z':
+			hemisphere, side = 1, 1
+		default:
+			return invalid("polar grid zone must be A, B, Y or Z")
+		}
+		columnIndex := strings.IndexByte(mgrsPolarColumnLetters[hemisphere][side], column)
+		rowIndex := strings.IndexByte(mgrsPolarRowLetters[hemisphere], row)
+		if columnIndex < 0 || rowIndex < 0 {
+			return invalid("100 kilometer square letters not in the polar grid zone")
+		}
+		columnIndex += mgrsPolarColumnStart[hemisphere] + side*len(mgrsPolarColumnLetters[hemisphere][0])
+		rowIndex += mgrsPolarRowStart[hemisphere]
+		easting := float64(columnIndex)*mgrsSquareSize + eastingOffset
+		northing := float64(rowIndex)*mgrsSquareSize + northingOffset
+		lat, lon = NewUPS(hemisphere == 0).Inverse(easting, northing)
+		return lat, lon, precision, nil
+	}
+
+	bandIndex := strings.IndexByte(utmBands, band)
+	if bandIndex < 0 {
+		return invalid("latitude band must be a letter from C to X, excluding I and O")
+	}
+	if band == 'X' && (zoneNumber == 32 || zoneNumber == 34 || zoneNumber == 36) {
+		return invalid("zones 32, 34 and 36 do not extend into band X, which Svalbard's zones cover")
+	}
+	columnIndex := strings.IndexByte(mgrsColumnLetters[(zoneNumber-1)%3], column)
+	rowIndex := strings.IndexByte(mgrsRowLetters, row)
+	if columnIndex < 0 || rowIndex < 0 {
+		return invalid("100 kilometer square letters not in the zone")
+	}
+	rowIndex = (rowIndex - (zoneNumber-1)%2*5 + len(mgrsRowLetters)) % len(mgrsRowLetters)
+
+	utm := NewUTM(zoneNumber, band < 'N')
+	easting := float64(columnIndex+1)*mgrsSquareSize + eastingOffset
+	northing := float64(rowIndex)*mgrsSquareSize + northingOffset
+	// the row letters repeat every 2000 kilometers, so find the repetition within the latitude band
+	_, bandNorthing := utm.Project(radians(float64(8*bandIndex-80)), utm.CentralMeridian())
+	cycle := float64(len(mgrsRowLetters)) * mgrsSquareSize
+	for northing < bandNorthing-mgrsSquareSize {
+		northing += cycle
+	}
+	lat, lon = utm.Inverse(easting, northing)
+	return lat, lon, precision, nil
+}
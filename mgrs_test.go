@@ -0,0 +1,99 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFormatMGRS(t *testing.T) {
+	testCases := []struct {
+		name      string
+		lat       float64
+		lon       float64
+		precision int
+		mgrs      string
+		usng      string
+	}{
+		{"Origin", 0, 0, 5, "31NAA6602100000", "31N AA 66021 00000"},
+		{"OriginKilometer", 0, 0, 2, "31NAA6600", "31N AA 66 00"},
+		{"OriginSquare", 0, 0, 0, "31NAA", "31N AA"},
+		{"Sydney", -33.8688, 151.2093, 5, "56HLH3436850948", "56H LH 34368 50948"},
+		{"NorthPole", 90, 0, 5, "ZAH0000000000", "Z AH 00000 00000"},
+		{"NorthPolarWest", 87, -90, 0, "YUH", "Y UH"},
+		{"SouthPole", -90, 0, 3, "BAN000000", "B AN 000 000"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mgrs, err := FormatMGRS(tc.lat*math.Pi/180, tc.lon*math.Pi/180, tc.precision)
+			if err != nil || mgrs != tc.mgrs {
+				t.Errorf("expected %s, got %s (%v)", tc.mgrs, mgrs, err)
+			}
+			usng, err := FormatUSNG(tc.lat*math.Pi/180, tc.lon*math.Pi/180, tc.precision)
+			if err != nil || usng != tc.usng {
+				t.Errorf("expected %s, got %s (%v)", tc.usng, usng, err)
+			}
+		})
+	}
+
+	if _, err := FormatMGRS(0, 0, 6); err == nil {
+		t.Errorf("expected an error for precision 6")
+	}
+}
+
+func TestParseMGRS(t *testing.T) {
+	testCases := []struct {
+		name      string
+		ref       string
+		precision int
+		lat       float64
+		lon       float64
+	}{
+		{"Origin", "31NAA6602100000", 5, 0, 0},
+		{"USNG", "31n aa 66021 00000", 5, 0, 0},
+		{"Sydney", "56HLH3436850948", 5, -33.8688, 151.2093},
+		{"NorthPole", "ZAH0000000000", 5, 90, 0},
+		{"SouthPole", "B AN 000 000", 3, -90, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lat, lon, precision, err := ParseMGRS(tc.ref)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if precision != tc.precision {
+				t.Errorf("expected precision %d, got %d", tc.precision, precision)
+			}
+			// the southwest corner of a one meter square is within a hundred thousandth of a degree
+			if !withinTolerance(lat, tc.lat*math.Pi/180, 0.00001*math.Pi/180) || (math.Abs(tc.lat) < 90 && !withinTolerance(lon, tc.lon*math.Pi/180, 0.00002*math.Pi/180)) {
+				t.Errorf("expected %f,%f, got %f,%f", tc.lat, tc.lon, lat*180/math.Pi, lon*180/math.Pi)
+			}
+		})
+	}
+
+	for _, ref := range []string{"", "31N", "61NAA", "31IAA", "31NAA123", "31NAI1234", "CAA", "31NAA12x4", "YJH", "ZKH", "32XNG", "34XEG", "36XWG"} {
+		if _, _, _, err := ParseMGRS(ref); err == nil {
+			t.Errorf("expected an error parsing %q", ref)
+		}
+	}
+}
+
+func TestMGRSRoundTrip(t *testing.T) {
+	for lat := -89.5; lat < 90; lat += 3.7 {
+		for lon := -179.5; lon < 180; lon += 7.3 {
+			ref, err := FormatMGRS(lat*math.Pi/180, lon*math.Pi/180, 5)
+			if err != nil {
+				t.Fatalf("unexpected error formatting %f,%f: %v", lat, lon, err)
+			}
+			parsedLat, parsedLon, _, err := ParseMGRS(ref)
+			if err != nil {
+				t.Fatalf("unexpected error parsing %s: %v", ref, err)
+			}
+			distance, _, _ := NewWGS84Geodesic().Inverse(lat*math.Pi/180, lon*math.Pi/180, parsedLat, parsedLon)
+			if distance > math.Sqrt2 {
+				t.Errorf("expected %s to be within a meter of %f,%f, got %f,%f", ref, lat, lon, parsedLat*180/math.Pi, parsedLon*180/math.Pi)
+			}
+		}
+	}
+}
@@ -9,73 +9,41 @@ type ObliqueAspect struct {
 	poleLon   float64
 	poleTheta float64
 
-	sinPoleLat float64 // cached precompute of sin(poleLat)
-	cosPoleLat float64 // cached precompute of cos(poleLat)
+	rotation Rotation // cached rotation from oblique locations to original locations
 }
 
 func NewObliqueAspect(poleLat float64, poleLon float64, poleTheta float64) ObliqueAspect {
 	return ObliqueAspect{
-		poleLat:    poleLat,
-		poleLon:    poleLon,
-		poleTheta:  poleTheta,
-		sinPoleLat: math.Sin(poleLat),
-		cosPoleLat: math.Cos(poleLat),
+		poleLat:   poleLat,
+		poleLon:   poleLon,
+		poleTheta: poleTheta,
+		rotation:  NewRotationFromPole(poleLat, poleLon, poleTheta),
 	}
 }
 
-// Applies the pole shift and rotation of the Oblique projection transform to the given
-// input latitude and longitude points, so that the returned latitude/longitude are able
-// to be used for the non-transformed 'original' projection.
-func (o ObliqueAspect) TransformFromOblique(latitude float64, longitude float64) (float64, float64) {
-	var newLat, newLon float64
-
-	poleRelCos := math.Cos(o.poleLon - longitude)
-
-	// relative latitude
-	if o.poleLat == math.Pi/2 {
-		newLat = latitude
-	} else {
-		preAsin := o.sinPoleLat*math.Sin(latitude) + o.cosPoleLat*math.Cos(latitude)*poleRelCos
-		if preAsin > 1 && preAsin < 1+1e-9 {
-			preAsin = 1
-		}
-		newLat = math.Asin(preAsin)
-	}
-
-	// relative longitude
-	switch o.poleLat {
-	case math.Pi / 2:
-		newLon = longitude - o.poleLon
-	case -math.Pi / 2:
-		newLon = o.poleLon - longitude - math.Pi
-	default:
-		numer := o.cosPoleLat*math.Sin(latitude) - o.sinPoleLat*math.Cos(latitude)*poleRelCos
-		denom := math.Cos(newLat)
-		newLon = math.Acos(numer/denom) - math.Pi
-
-		if math.IsNaN(newLon) {
-			if (poleRelCos >= 0 && latitude < o.poleLat) || (poleRelCos < 0 && latitude < -o.poleLat) {
-				newLon = 0
-			} else {
-				newLon = -math.Pi
-			}
-		} else if math.Sin(longitude-o.poleLon) > 0 {
-			newLon = -newLon
-		}
+// Construct an oblique aspect which applies the given rotation to locations before they are projected by the
+// original projection. See NewRotationFromPole for the relationship between rotations and pole positions.
+func NewObliqueAspectFromRotation(rotation Rotation) ObliqueAspect {
+	poleLat, poleLon, poleTheta := rotation.Pole()
+	return ObliqueAspect{
+		poleLat:   poleLat,
+		poleLon:   poleLon,
+		poleTheta: poleTheta,
+		rotation:  rotation,
 	}
+}
 
-	// apply rotate
-	newLon = newLon - o.poleTheta
-
-	// constrain and kill roundoff error
-	if math.Abs(newLon) > math.Pi {
-		newLon = coerceAngle(newLon)
-	}
-	if newLon >= math.Pi-1e-7 {
-		newLon = -math.Pi
-	}
+// The rotation from locations in the oblique projection space to locations in the 'original' projection space.
+func (o ObliqueAspect) Rotation() Rotation {
+	return o.rotation
+}
 
-	return newLat, newLon
+// Applies the pole shift and rotation of the Oblique projection transform to the given
+// input latitude and longitude points, so that the returned latitude/longitude are able
+// to be used for the non-transformed 'original' projection. Longitudes are returned in (-Pi, Pi], as
+// Rotation.Apply gives them.
+func (o ObliqueAspect) TransformFromOblique(latitude float64, longitude float64) (float64, float64) {
+	return o.rotation.Apply(latitude, longitude)
 }
 
 func coerceAngle(angle float64) float64 {
@@ -87,40 +55,9 @@ func coerceAngle(angle float64) float64 {
 
 // Given a latitude/longitude in the non-transformed 'original' projection space, applies
 // the pole shift and rotation of the Oblique projection so that the returned latitude/longitude
-// are in the Oblique projection space.
+// are in the Oblique projection space. Longitudes are returned in (-Pi, Pi].
 func (o ObliqueAspect) TransformToOblique(latitude float64, longitude float64) (float64, float64) {
-	rotateLon := longitude + o.poleTheta
-
-	preAsin := o.sinPoleLat*math.Sin(latitude) - o.cosPoleLat*math.Cos(latitude)*math.Cos(rotateLon)
-	if preAsin > 1 && preAsin < 1+1e-9 {
-		preAsin = 1
-	}
-	if preAsin < -1 && preAsin > -1-1e-9 {
-		preAsin = -1
-	}
-	newLat := math.Asin(preAsin)
-	var newLon float64
-	inner := math.Sin(latitude)/o.cosPoleLat/math.Cos(newLat) - math.Tan(o.poleLat)*math.Tan(newLat)
-	if o.poleLat == math.Pi/2 {
-		newLon = rotateLon + o.poleLon
-	} else if o.poleLat == -math.Pi/2 {
-		newLon = -rotateLon + o.poleLon + math.Pi
-	} else if math.Abs(inner) > 1 {
-		if (rotateLon == 0 && latitude < -o.poleLat) || (rotateLon != 0 && latitude < o.poleLat) {
-			newLon = o.poleLon + math.Pi
-		} else {
-			newLon = o.poleLon
-		}
-	} else if math.Sin(rotateLon) > 0 {
-		newLon = o.poleLon + math.Acos(inner)
-	} else {
-		newLon = o.poleLon - math.Acos(inner)
-	}
-
-	if math.Abs(newLon) > math.Pi {
-		newLon = coerceAngle(newLon)
-	}
-	return newLat, newLon
+	return o.rotation.ApplyInverse(latitude, longitude)
 }
 
 type ObliqueProjection struct {
@@ -135,6 +72,15 @@ func NewObliqueProjection(original Projection, poleLat float64, poleLon float64,
 	}
 }
 
+// Construct an oblique projection which applies the given rotation to locations before they are projected by
+// the original projection.
+func NewObliqueProjectionFromRotation(original Projection, rotation Rotation) ObliqueProjection {
+	return ObliqueProjection{
+		original,
+		NewObliqueAspectFromRotation(rotation),
+	}
+}
+
 func (o ObliqueProjection) Project(latitude float64, longitude float64) (float64, float64) {
 	return o.orig.Project(o.TransformFromOblique(latitude, longitude))
 }
@@ -146,3 +92,71 @@ func (o ObliqueProjection) Inverse(x float64, y float64) (float64, float64) {
 func (o ObliqueProjection) PlanarBounds() Bounds {
 	return o.orig.PlanarBounds()
 }
+
+// Describes the oblique projection in terms of the original projection's metadata. Rotation preserves the
+// local properties of the original, but not its straight rhumb lines, nor its suitability to the poles.
+func (o ObliqueProjection) Describe() Metadata {
+	meta := Metadata{Name: "Oblique projection"}
+	if describer, ok := o.orig.(Describer); ok {
+		meta = describer.Describe()
+		meta.Aliases = nil
+	}
+
+	switch {
+	case math.Abs(o.poleLat) == math.Pi/2:
+		// the pole stays in place, so the result is only shifted in longitude
+	case o.poleLat == 0:
+		meta.Aspect = AspectTransverse
+		meta.Name = "Transverse " + meta.Name
+		meta.Properties &^= StraightRhumbLines
+	default:
+		meta.Aspect = AspectOblique
+		meta.Name = "Oblique " + meta.Name
+		meta.Properties &^= StraightRhumbLines
+	}
+	if meta.Aspect != AspectNormal {
+		meta.EPSGMethod = 0
+		meta.PROJName = "ob_tran"
+	}
+	return meta
+}
+
+// A projection shifted in longitude so that the given central meridian, rather than the prime meridian, lies
+// at the center of the projection plane. Equivalent to an ObliqueProjection that only rotates about the poles,
+// but much cheaper to compute.
+type MeridianShift struct {
+	orig            Projection
+	CentralMeridian float64 // The longitude (in radians) placed at the center of the projection.
+}
+
+func NewMeridianShift(original Projection, centralMeridian float64) MeridianShift {
+	return MeridianShift{original, centralMeridian}
+}
+
+func (m MeridianShift) Project(latitude float64, longitude float64) (float64, float64) {
+	shifted := longitude - m.CentralMeridian
+	if math.Abs(shifted) > math.Pi {
+		shifted = coerceAngle(shifted)
+	}
+	return m.orig.Project(latitude, shifted)
+}
+
+func (m MeridianShift) Inverse(x float64, y float64) (float64, float64) {
+	lat, lon := m.orig.Inverse(x, y)
+	lon += m.CentralMeridian
+	if math.Abs(lon) > math.Pi {
+		lon = coerceAngle(lon)
+	}
+	return lat, lon
+}
+
+func (m MeridianShift) PlanarBounds() Bounds {
+	return m.orig.PlanarBounds()
+}
+
+func (m MeridianShift) Describe() Metadata {
+	if describer, ok := m.orig.(Describer); ok {
+		return describer.Describe()
+	}
+	return Metadata{Name: "Meridian shifted projection"}
+}
@@ -13,6 +13,7 @@ func FuzzObliqueNoOp(f *testing.F) {
 	f.Add(math.Pi/4, math.Pi/4)
 	f.Add(math.Pi/2, 0.0)
 	f.Add(0.0, math.Pi/2)
+	f.Add(math.Pi/2, 0.1122)
 	f.Fuzz(func(t *testing.T, lat float64, lon float64) {
 		if math.Abs(lat) > math.Pi/2 {
 			lat = math.Mod(lat, math.Pi/2)
@@ -44,6 +45,7 @@ func FuzzObliquePlateCarreeVsCassiniProject(f *testing.F) {
 	f.Add(math.Pi/4, math.Pi/4)
 	f.Add(math.Pi/2, 0.0)
 	f.Add(0.0, math.Pi/2)
+	f.Add(0.0, -3.0)
 	f.Fuzz(func(t *testing.T, lat float64, lon float64) {
 		if math.Abs(lat) > math.Pi/2 {
 			lat = math.Mod(lat, math.Pi/2)
@@ -55,9 +57,37 @@ func FuzzObliquePlateCarreeVsCassiniProject(f *testing.F) {
 		xo, yo := obliqEq.Project(lat, lon)
 		xc, yc := cassini.Project(lat, lon)
 		xr, yr := rotatePoint(xc, yc, math.Pi/2)
+		if withinTolerance(math.Abs(xo), math.Pi, 0.000001) && withinTolerance(xo, -xr, 0.000001) {
+			// 180 degrees, which the oblique aspect places at Pi, and the Cassini projection at either edge
+			xr = xo
+		}
 
 		if !withinTolerance(xo, xr, 0.000001) || !withinTolerance(yo, yr, 0.000001) {
 			t.Errorf("expected %e,%e, but got %e,%e", xr, yr, xo, yo)
 		}
 	})
 }
+
+func TestObliqueSeamSide(t *testing.T) {
+	testCases := []struct {
+		name     string
+		aspect   ObliqueAspect
+		lat, lon float64
+		rlat     float64
+	}{
+		{"Transverse", NewObliqueAspect(0, math.Pi/2, -math.Pi/2), 0, -3, 3 - math.Pi},
+		{"Shifted", NewObliqueAspect(math.Pi/2, math.Pi/2, 0), 0.3, -math.Pi / 2, 0.3},
+		{"Flipped", NewObliqueAspect(-math.Pi/2, 0, 0), -0.3, 0, 0.3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if lat, lon := tc.aspect.TransformFromOblique(tc.lat, tc.lon); !withinTolerance(lat, tc.rlat, 1e-12) || lon != math.Pi {
+				t.Errorf("expected %f,%f to rotate onto %f,%f, got %f,%f", tc.lat, tc.lon, tc.rlat, math.Pi, lat, lon)
+			}
+			if lat, lon := tc.aspect.TransformToOblique(tc.rlat, -math.Pi); !withinTolerance(lat, tc.lat, 1e-12) || !withinTolerance(lon, tc.lon, 1e-12) {
+				t.Errorf("expected %f,%f to rotate back onto %f,%f, got %f,%f", tc.rlat, -math.Pi, tc.lat, tc.lon, lat, lon)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package flatsphere
+
+import (
+	"math"
+)
+
+// A measure of how much a projection distorts a set of locations on the sphere, where smaller is better.
+type DistortionMetric int
+
+const (
+	// The mean deviation of the logarithmic area scale from its average over the locations. Measuring the
+	// deviation, rather than the area scale itself, ignores the overall scale of the projection.
+	MeanAreaDistortion DistortionMetric = iota
+	// The largest deviation of the logarithmic area scale from its average over the locations.
+	MaxAreaDistortion
+	// The mean angular distortion over the locations.
+	MeanAngularDistortion
+	// The largest angular distortion over the locations.
+	MaxAngularDistortion
+)
+
+// Score the distortion of a projection over the given latitude/longitude locations in radians, according to
+// the metric. Returns positive infinity if the distortion is undefined at any of the locations, such as where
+// the projection diverges.
+func DistortionScore(proj Projection, samples [][2]float64, metric DistortionMetric) float64 {
+	if len(samples) == 0 {
+		return 0
+	}
+	values := make([]float64, len(samples))
+	mean := 0.0
+	for i, sample := range samples {
+		if metric == MeanAreaDistortion || metric == MaxAreaDistortion {
+			values[i] = AreaDistortionAt(proj, sample[0], sample[1])
+		} else {
+			values[i] = AngularDistortionAt(proj, sample[0], sample[1])
+		}
+		if math.IsNaN(values[i]) || math.IsInf(values[i], 0) {
+			return math.Inf(1)
+		}
+		mean += values[i] / float64(len(samples))
+	}
+
+	score := 0.0
+	for _, value := range values {
+		if metric == MeanAreaDistortion || metric == MaxAreaDistortion {
+			value = math.Abs(value - mean)
+		}
+		if metric == MeanAreaDistortion || metric == MeanAngularDistortion {
+			score += value / float64(len(samples))
+		} else {
+			score = math.Max(score, value)
+		}
+	}
+	return score
+}
+
+// Search for the oblique aspect of the given projection which minimizes its distortion over the given
+// latitude/longitude locations in radians, according to the metric. Returns the best oblique projection
+// found along with its distortion score. The search covers all pole positions and rotations coarsely before
+// refining the best candidate, so it finds a good aspect but is not guaranteed to find the global optimum.
+func OptimizeOblique(proj Projection, samples [][2]float64, metric DistortionMetric) (ObliqueProjection, float64) {
+	score := func(params [3]float64) float64 {
+		return DistortionScore(NewObliqueProjection(proj, params[0], params[1], params[2]), samples, metric)
+	}
+
+	// coarse search over the whole parameter space, starting from the normal aspect
+	step := math.Pi / 6
+	best := [3]float64{math.Pi / 2, 0, 0}
+	bestScore := score(best)
+	for poleLat := -math.Pi / 2; poleLat <= math.Pi/2; poleLat += step {
+		for poleLon := -math.Pi; poleLon < math.Pi; poleLon += step {
+			for poleTheta := -math.Pi; poleTheta < math.Pi; poleTheta += step {
+				candidate := [3]float64{poleLat, poleLon, poleTheta}
+				if candidateScore := score(candidate); candidateScore < bestScore {
+					best, bestScore = candidate, candidateScore
+				}
+			}
+		}
+	}
+
+	// refine with a compass search, shrinking the step whenever no neighbor improves on the best candidate
+	for step /= 2; step > 1e-6; {
+		improved := false
+		for i := range best {
+			for _, dir := range []float64{-1, 1} {
+				candidate := best
+				candidate[i] += dir * step
+				if i == 0 {
+					candidate[0] = math.Max(-math.Pi/2, math.Min(math.Pi/2, candidate[0]))
+				} else {
+					candidate[i] = coerceAngle(candidate[i])
+				}
+				if candidateScore := score(candidate); candidateScore < bestScore {
+					best, bestScore = candidate, candidateScore
+					improved = true
+				}
+			}
+		}
+		if !improved {
+			step /= 2
+		}
+	}
+	return NewObliqueProjection(proj, best[0], best[1], best[2]), bestScore
+}
+
+// Search for the oblique aspect of the given projection which minimizes its distortion over the interior of
+// the polygon, according to the metric. The polygon is sampled on a grid of roughly even spacing across the
+// sphere, with the given number of rows between the poles. See OptimizeOblique.
+func OptimizeObliquePolygon(proj Projection, polygon SphericalPolygon, rows int, metric DistortionMetric) (ObliqueProjection, float64) {
+	return OptimizeOblique(proj, polygonSamples(polygon, rows), metric)
+}
+
+// Sample locations inside the polygon on a grid of rows of latitude, with fewer samples per row toward the
+// poles so that the samples are spread roughly evenly in area. Falls back to the polygon's vertices when
+// the grid is too coarse to place any samples inside it.
+func polygonSamples(polygon SphericalPolygon, rows int) [][2]float64 {
+	rows = max(rows, 1)
+	samples := [][2]float64{}
+	spacing := math.Pi / float64(rows)
+	for r := 0; r < rows; r++ {
+		lat := -math.Pi/2 + (float64(r)+0.5)*spacing
+		cols := max(int(math.Round(2*float64(rows)*math.Cos(lat))), 1)
+		for c := 0; c < cols; c++ {
+			lon := -math.Pi + (float64(c)+0.5)*2*math.Pi/float64(cols)
+			if polygon.Contains(lat, lon) {
+				samples = append(samples, [2]float64{lat, lon})
+			}
+		}
+	}
+	if len(samples) == 0 {
+		samples = append(samples, polygon.Outer...)
+	}
+	return samples
+}
@@ -0,0 +1,54 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOptimizeObliqueMeridianStrip(t *testing.T) {
+	// a long strip along a meridian is best shown by a transverse aspect of a cylindrical projection
+	samples := [][2]float64{}
+	for lat := -1.2; lat <= 1.2; lat += 0.1 {
+		samples = append(samples, [2]float64{lat, 0.5})
+	}
+	normal := DistortionScore(NewMercator(), samples, MeanAreaDistortion)
+	oblique, score := OptimizeOblique(NewMercator(), samples, MeanAreaDistortion)
+	if score > 0.01 || score >= normal {
+		t.Errorf("expected optimized distortion near zero and below normal aspect %e, got %e", normal, score)
+	}
+	if !withinTolerance(DistortionScore(oblique, samples, MeanAreaDistortion), score, 0.000001) {
+		t.Errorf("expected returned score %e to match the returned projection", score)
+	}
+}
+
+func TestOptimizeObliquePolygon(t *testing.T) {
+	// an azimuthal projection is least distorted when centered on a small region
+	center := [2]float64{0.7, 1.0}
+	outer := [][2]float64{}
+	for i := 0; i < 12; i++ {
+		lat, lon := Destination(center[0], center[1], 0.3, float64(i)*math.Pi/6)
+		outer = append(outer, [2]float64{lat, lon})
+	}
+	polygon := NewSphericalPolygon(outer).NormalizeWinding()
+	oblique, score := OptimizeObliquePolygon(NewStereographic(), polygon, 60, MeanAreaDistortion)
+	normal := DistortionScore(NewStereographic(), polygonSamples(polygon, 60), MeanAreaDistortion)
+	if score >= normal {
+		t.Errorf("expected optimized distortion below normal aspect %e, got %e", normal, score)
+	}
+	if !withinTolerance(oblique.poleLat, center[0], 0.01) || !withinTolerance(oblique.poleLon, center[1], 0.01) {
+		t.Errorf("expected aspect centered on %e,%e, got %e,%e", center[0], center[1], oblique.poleLat, oblique.poleLon)
+	}
+}
+
+func TestDistortionScore(t *testing.T) {
+	samples := [][2]float64{{0, 0}, {0.5, 1}, {-1, 2}}
+	if score := DistortionScore(NewLambertCylindrical(), samples, MaxAreaDistortion); !withinTolerance(score, 0, 0.000001) {
+		t.Errorf("expected no area distortion for an equal-area projection, got %e", score)
+	}
+	if score := DistortionScore(NewMercator(), samples, MaxAngularDistortion); !withinTolerance(score, 0, 0.000001) {
+		t.Errorf("expected no angular distortion for a conformal projection, got %e", score)
+	}
+	if score := DistortionScore(NewMercator(), [][2]float64{{math.Pi / 2, 0}}, MeanAreaDistortion); !math.IsInf(score, 1) {
+		t.Errorf("expected infinite score at a diverging pole, got %e", score)
+	}
+}
@@ -0,0 +1,252 @@
+package flatsphere
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// Orient a north polar azimuthal projection, such as NewGnomonic() or NewStereographic(), as a camera at the
+// center of a panorama. The camera looks toward the given yaw (longitude) and pitch (latitude) in radians,
+// and is then rolled about its view direction by the given angle, with positive values turning the camera
+// clockwise so the scene appears turned counter-clockwise. With no roll, the upward direction of the scene
+// (toward the zenith) is at the top of the projection plane. Looking straight up or down, the yaw instead
+// sets the direction at the bottom or top of the plane respectively.
+func NewPanoramaView(azimuthal Projection, yaw float64, pitch float64, roll float64) ObliqueProjection {
+	// a point a quarter turn above the view direction, which should appear straight up in the plane
+	upLat, upLon := math.Pi/2-math.Abs(pitch), yaw
+	if pitch > 0 {
+		upLon += math.Pi
+	}
+	_, orientLon := NewObliqueAspect(pitch, yaw, 0).TransformFromOblique(upLat, coerceAngle(upLon))
+	return NewObliqueProjection(azimuthal, pitch, yaw, coerceAngle(orientLon-math.Pi-roll))
+}
+
+// A rectilinear (pinhole camera) view of a panorama, in which straight lines in the scene remain straight.
+// Points in the view direction are projected onto the plane at unit distance from the camera, so a view with
+// horizontal field of view hfov spans x values of plus or minus tan(hfov/2). See NewPanoramaView.
+func NewRectilinearView(yaw float64, pitch float64, roll float64) ObliqueProjection {
+	return NewPanoramaView(NewGnomonic(), yaw, pitch, roll)
+}
+
+// A "little planet" view of a panorama, looking straight down from above the ground with the stereographic
+// projection, so that the horizon wraps into a circle around the ground and the sky surrounds it. The yaw
+// (in radians) is placed at the top of the view. A point at angle c from the nadir is projected at a distance
+// of tan(c/2) from the center of the plane. See NewPanoramaView.
+func NewLittlePlanetView(yaw float64, roll float64) ObliqueProjection {
+	return NewPanoramaView(NewStereographic(), yaw, -math.Pi/2, roll)
+}
+
+// Render a view of a panorama stored as a full 360 by 180 degree equirectangular (plate carrée) image,
+// where longitude -Pi is at the left edge and latitude Pi/2 at the top. Each pixel of the resulting image
+// of the given size covers an equal part of the given window of the view's projection plane.
+func RenderPanoramaView(equirectangular image.Image, view Projection, window RectangleBounds, width int, height int) *image.RGBA64 {
+	rendered := image.NewRGBA64(image.Rect(0, 0, width, height))
+	for j := 0; j < height; j++ {
+		y := window.YMax - (float64(j)+0.5)/float64(height)*window.Height()
+		for i := 0; i < width; i++ {
+			x := window.XMin + (float64(i)+0.5)/float64(width)*window.Width()
+			lat, lon := view.Inverse(x, y)
+			if math.IsNaN(lat) || math.IsNaN(lon) {
+				continue
+			}
+			rendered.SetRGBA64(i, j, sampleEquirectangular(equirectangular, lat, lon))
+		}
+	}
+	return rendered
+}
+
+// Render a rectilinear view of an equirectangular panorama with the given orientation and horizontal field
+// of view in radians, which must be less than Pi. See NewRectilinearView and RenderPanoramaView.
+func RectilinearFromEquirectangular(equirectangular image.Image, yaw float64, pitch float64, roll float64, hfov float64, width int, height int) *image.RGBA64 {
+	halfWidth := math.Tan(hfov / 2)
+	halfHeight := halfWidth * float64(height) / float64(width)
+	window := RectangleBounds{XMin: -halfWidth, XMax: halfWidth, YMin: -halfHeight, YMax: halfHeight}
+	return RenderPanoramaView(equirectangular, NewRectilinearView(yaw, pitch, roll), window, width, height)
+}
+
+// Render a square "little planet" view of an equirectangular panorama, showing everything within half the
+// given field of view (in radians, less than 2Pi) of the nadir. See NewLittlePlanetView and RenderPanoramaView.
+func LittlePlanetFromEquirectangular(equirectangular image.Image, yaw float64, roll float64, fov float64, size int) *image.RGBA64 {
+	half := math.Tan(fov / 4)
+	return RenderPanoramaView(equirectangular, NewLittlePlanetView(yaw, roll), NewRectangleBounds(2*half, 2*half), size, size)
+}
+
+// One of the six faces of a cube surrounding the camera at the center of a panorama.
+type CubeFace int
+
+const (
+	FaceFront CubeFace = iota // Facing longitude 0 on the horizon.
+	FaceRight                 // Facing longitude Pi/2 on the horizon.
+	FaceBack                  // Facing longitude Pi on the horizon.
+	FaceLeft                  // Facing longitude -Pi/2 on the horizon.
+	FaceUp                    // Facing the zenith, with the front face below it.
+	FaceDown                  // Facing the nadir, with the front face above it.
+)
+
+// Find the cube face a location on the sphere (in radians) is projected onto, and its coordinates on that
+// face. Each face is a rectilinear view with a 90 degree field of view, with coordinates u (rightward) and
+// v (upward) from -1 to 1. Adjacent faces meet along their edges as in the horizontal cross layout.
+func CubeFaceCoordinates(lat float64, lon float64) (face CubeFace, u float64, v float64) {
+	x, y, z := sphericalToCartesian(lat, lon)
+	ax, ay, az := math.Abs(x), math.Abs(y), math.Abs(z)
+	switch {
+	case az >= ax && az >= ay && z > 0:
+		return FaceUp, y / z, -x / z
+	case az >= ax && az >= ay:
+		return FaceDown, y / -z, x / -z
+	case ax >= ay && x > 0:
+		return FaceFront, y / x, z / x
+	case ax >= ay:
+		return FaceBack, y / x, z / -x
+	case y > 0:
+		return FaceRight, -x / y, z / y
+	default:
+		return FaceLeft, x / -y, z / -y
+	}
+}
+
+// Find the location on the sphere (in radians) at the given coordinates of a cube face. The reverse of
+// CubeFaceCoordinates.
+func CubeFaceLocation(face CubeFace, u float64, v float64) (lat float64, lon float64) {
+	var x, y, z float64
+	switch face {
+	case FaceUp:
+		x, y, z = -v, u, 1
+	case FaceDown:
+		x, y, z = v, u, -1
+	case FaceFront:
+		x, y, z = 1, u, v
+	case FaceBack:
+		x, y, z = -1, -u, v
+	case FaceRight:
+		x, y, z = -u, 1, v
+	default:
+		x, y, z = u, -1, v
+	}
+	return cartesianToSpherical(x, y, z)
+}
+
+// An arrangement of the six faces of a cubemap within a single image, made of square tiles.
+type CubemapLayout int
+
+const (
+	// A 4 by 3 tile cross, with the left, front, right and back faces along the middle row, and the up and
+	// down faces above and below the front face.
+	LayoutHorizontalCross CubemapLayout = iota
+	// A 6 by 1 tile strip, with the faces in the order front, right, back, left, up, down.
+	LayoutStrip
+	// A 3 by 2 tile grid, with the front, right and back faces on the top row, and the left, up and down
+	// faces on the bottom row.
+	LayoutGrid
+)
+
+// The width and height of the layout in tiles.
+func (l CubemapLayout) Tiles() (columns int, rows int) {
+	switch l {
+	case LayoutStrip:
+		return 6, 1
+	case LayoutGrid:
+		return 3, 2
+	default:
+		return 4, 3
+	}
+}
+
+// The column and row of the tile holding the given face in the layout.
+func (l CubemapLayout) FaceTile(face CubeFace) (column int, row int) {
+	switch l {
+	case LayoutStrip:
+		return int(face), 0
+	case LayoutGrid:
+		return int(face) % 3, int(face) / 3
+	default:
+		switch face {
+		case FaceUp:
+			return 1, 0
+		case FaceDown:
+			return 1, 2
+		case FaceLeft:
+			return 0, 1
+		default:
+			return int(face) + 1, 1
+		}
+	}
+}
+
+// Convert an equirectangular panorama into a cubemap with faces of the given size in pixels, arranged in
+// the given layout. Tiles of the layout not holding a face are left transparent.
+func CubemapFromEquirectangular(equirectangular image.Image, faceSize int, layout CubemapLayout) *image.RGBA64 {
+	columns, rows := layout.Tiles()
+	cubemap := image.NewRGBA64(image.Rect(0, 0, columns*faceSize, rows*faceSize))
+	for face := FaceFront; face <= FaceDown; face++ {
+		column, row := layout.FaceTile(face)
+		for j := 0; j < faceSize; j++ {
+			v := 1 - 2*(float64(j)+0.5)/float64(faceSize)
+			for i := 0; i < faceSize; i++ {
+				u := 2*(float64(i)+0.5)/float64(faceSize) - 1
+				lat, lon := CubeFaceLocation(face, u, v)
+				cubemap.SetRGBA64(column*faceSize+i, row*faceSize+j, sampleEquirectangular(equirectangular, lat, lon))
+			}
+		}
+	}
+	return cubemap
+}
+
+// Convert a cubemap with faces arranged in the given layout into an equirectangular panorama of the given size.
+// The size of the faces is determined from the size of the cubemap image.
+func EquirectangularFromCubemap(cubemap image.Image, layout CubemapLayout, width int, height int) *image.RGBA64 {
+	columns, _ := layout.Tiles()
+	faceSize := cubemap.Bounds().Dx() / columns
+	equirectangular := image.NewRGBA64(image.Rect(0, 0, width, height))
+	for j := 0; j < height; j++ {
+		lat := math.Pi/2 - (float64(j)+0.5)/float64(height)*math.Pi
+		for i := 0; i < width; i++ {
+			lon := (float64(i)+0.5)/float64(width)*2*math.Pi - math.Pi
+			face, u, v := CubeFaceCoordinates(lat, lon)
+			column, row := layout.FaceTile(face)
+			tile := image.Rect(column*faceSize, row*faceSize, (column+1)*faceSize, (row+1)*faceSize).Add(cubemap.Bounds().Min)
+			fx := float64(tile.Min.X) + (u+1)/2*float64(faceSize) - 0.5
+			fy := float64(tile.Min.Y) + (1-v)/2*float64(faceSize) - 0.5
+			equirectangular.SetRGBA64(i, j, sampleBilinear(cubemap, tile, fx, fy, false))
+		}
+	}
+	return equirectangular
+}
+
+// Sample the color of an equirectangular panorama at the given location, interpolating between pixels.
+func sampleEquirectangular(equirectangular image.Image, lat float64, lon float64) color.RGBA64 {
+	bounds := equirectangular.Bounds()
+	fx := float64(bounds.Min.X) + (coerceAngle(lon)+math.Pi)/(2*math.Pi)*float64(bounds.Dx()) - 0.5
+	fy := float64(bounds.Min.Y) + (math.Pi/2-lat)/math.Pi*float64(bounds.Dy()) - 0.5
+	return sampleBilinear(equirectangular, bounds, fx, fy, true)
+}
+
+// Bilinearly interpolate the color of an image at fractional pixel coordinates, where integer coordinates
+// are pixel centers, using only pixels within the given rectangle. Coordinates beyond the rectangle are
+// clamped to its edges, or wrapped around horizontally if requested.
+func sampleBilinear(img image.Image, rect image.Rectangle, fx float64, fy float64, wrapX bool) color.RGBA64 {
+	x0, y0 := math.Floor(fx), math.Floor(fy)
+	tx, ty := fx-x0, fy-y0
+	pixel := func(x int, y int) [4]float64 {
+		if wrapX {
+			x = rect.Min.X + ((x-rect.Min.X)%rect.Dx()+rect.Dx())%rect.Dx()
+		} else {
+			x = min(max(x, rect.Min.X), rect.Max.X-1)
+		}
+		y = min(max(y, rect.Min.Y), rect.Max.Y-1)
+		r, g, b, a := img.At(x, y).RGBA()
+		return [4]float64{float64(r), float64(g), float64(b), float64(a)}
+	}
+
+	ix, iy := int(x0), int(y0)
+	c00, c10 := pixel(ix, iy), pixel(ix+1, iy)
+	c01, c11 := pixel(ix, iy+1), pixel(ix+1, iy+1)
+	var mixed [4]uint16
+	for k := range mixed {
+		top := c00[k]*(1-tx) + c10[k]*tx
+		bottom := c01[k]*(1-tx) + c11[k]*tx
+		mixed[k] = uint16(math.Round(top*(1-ty) + bottom*ty))
+	}
+	return color.RGBA64{R: mixed[0], G: mixed[1], B: mixed[2], A: mixed[3]}
+}
@@ -0,0 +1,144 @@
+package flatsphere
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestCubeFaceRoundTrip(t *testing.T) {
+	for lat := -1.5; lat <= 1.5; lat += 0.25 {
+		for lon := -3.0; lon <= 3.0; lon += 0.25 {
+			face, u, v := CubeFaceCoordinates(lat, lon)
+			if math.Abs(u) > 1 || math.Abs(v) > 1 {
+				t.Errorf("expected face coordinates within the face for %e,%e, got %e,%e", lat, lon, u, v)
+			}
+			rlat, rlon := CubeFaceLocation(face, u, v)
+			if !withinTolerance(rlat, lat, 0.000001) || !withinTolerance(rlon, lon, 0.000001) {
+				t.Errorf("expected %e,%e from face %d, got %e,%e", lat, lon, face, rlat, rlon)
+			}
+		}
+	}
+}
+
+func TestCubeFaceEdges(t *testing.T) {
+	// the top edge of the front face meets the bottom edge of the up face, and so on around the front face
+	testCases := []struct {
+		name  string
+		face1 CubeFace
+		u1    float64
+		v1    float64
+		face2 CubeFace
+		u2    float64
+		v2    float64
+	}{
+		{"FrontUp", FaceFront, 0.5, 1, FaceUp, 0.5, -1},
+		{"FrontDown", FaceFront, 0.5, -1, FaceDown, 0.5, 1},
+		{"FrontRight", FaceFront, 1, 0.5, FaceRight, -1, 0.5},
+		{"LeftFront", FaceLeft, 1, 0.5, FaceFront, -1, 0.5},
+		{"BackLeft", FaceBack, 1, 0.5, FaceLeft, -1, 0.5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lat1, lon1 := CubeFaceLocation(tc.face1, tc.u1, tc.v1)
+			lat2, lon2 := CubeFaceLocation(tc.face2, tc.u2, tc.v2)
+			if !withinTolerance(lat1, lat2, 0.000001) || !withinTolerance(math.Cos(lon1-lon2), 1, 0.000001) {
+				t.Errorf("expected shared edge, got %e,%e and %e,%e", lat1, lon1, lat2, lon2)
+			}
+		})
+	}
+}
+
+func TestPanoramaViewOrientation(t *testing.T) {
+	testCases := []struct {
+		name  string
+		yaw   float64
+		pitch float64
+		roll  float64
+	}{
+		{"Level", 1.0, 0, 0},
+		{"Raised", -2.0, 0.6, 0},
+		{"Lowered", 0.5, -0.9, 0},
+		{"Rolled", 0.3, 0.2, 0.4},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			view := NewRectilinearView(tc.yaw, tc.pitch, tc.roll)
+			x, y := view.Project(tc.pitch, tc.yaw)
+			if !withinTolerance(x, 0, 0.000001) || !withinTolerance(y, 0, 0.000001) {
+				t.Errorf("expected view direction at the origin, got %e,%e", x, y)
+			}
+			// a point just above the view direction appears above the center, turned by the roll
+			x, y = view.Project(tc.pitch+0.1, tc.yaw)
+			if angle := math.Atan2(x, y); !withinTolerance(angle, -tc.roll, 0.000001) {
+				t.Errorf("expected upward direction at angle %e, got %e", -tc.roll, angle)
+			}
+			// a point to the right of the view direction on the horizon appears to the right
+			if tc.pitch == 0 && tc.roll == 0 {
+				x, y = view.Project(0, tc.yaw+0.1)
+				if x <= 0 || !withinTolerance(y, 0, 0.000001) {
+					t.Errorf("expected point to the right on the horizon, got %e,%e", x, y)
+				}
+			}
+		})
+	}
+}
+
+func TestLittlePlanetView(t *testing.T) {
+	view := NewLittlePlanetView(1.2, 0)
+	if x, y := view.Project(-math.Pi/2, 0); !withinTolerance(x, 0, 0.000001) || !withinTolerance(y, 0, 0.000001) {
+		t.Errorf("expected nadir at the origin, got %e,%e", x, y)
+	}
+	x, y := view.Project(0, 1.2)
+	if !withinTolerance(x, 0, 0.000001) || !withinTolerance(y, 1, 0.000001) {
+		t.Errorf("expected yaw on the horizon at the top of the unit circle, got %e,%e", x, y)
+	}
+}
+
+// A panorama in which the red channel increases with longitude and the green channel with latitude.
+func gradientPanorama(width int, height int) *image.RGBA64 {
+	img := image.NewRGBA64(image.Rect(0, 0, width, height))
+	for j := 0; j < height; j++ {
+		for i := 0; i < width; i++ {
+			img.SetRGBA64(i, j, color.RGBA64{R: uint16(i * 65535 / (width - 1)), G: uint16((height - 1 - j) * 65535 / (height - 1)), A: 65535})
+		}
+	}
+	return img
+}
+
+func TestCubemapEquirectangularRoundTrip(t *testing.T) {
+	pano := gradientPanorama(256, 128)
+	for _, layout := range []CubemapLayout{LayoutHorizontalCross, LayoutStrip, LayoutGrid} {
+		cubemap := CubemapFromEquirectangular(pano, 64, layout)
+		columns, rows := layout.Tiles()
+		if cubemap.Bounds().Dx() != columns*64 || cubemap.Bounds().Dy() != rows*64 {
+			t.Errorf("expected %dx%d tile cubemap, got %v", columns, rows, cubemap.Bounds())
+		}
+		back := EquirectangularFromCubemap(cubemap, layout, 256, 128)
+		// compare away from the antimeridian, where the gradient wraps
+		for j := 8; j < 120; j += 7 {
+			for i := 16; i < 240; i += 9 {
+				want := pano.RGBA64At(i, j)
+				got := back.RGBA64At(i, j)
+				if math.Abs(float64(want.R)-float64(got.R)) > 1500 || math.Abs(float64(want.G)-float64(got.G)) > 1500 {
+					t.Fatalf("layout %d: expected %v at %d,%d, got %v", layout, want, i, j, got)
+				}
+			}
+		}
+	}
+}
+
+func TestRectilinearFromEquirectangular(t *testing.T) {
+	pano := gradientPanorama(360, 180)
+	view := RectilinearFromEquirectangular(pano, 0.5, 0.2, 0, math.Pi/2, 33, 21)
+	center := view.RGBA64At(16, 10)
+	want := sampleEquirectangular(pano, 0.2, 0.5)
+	if math.Abs(float64(center.R)-float64(want.R)) > 300 || math.Abs(float64(center.G)-float64(want.G)) > 300 {
+		t.Errorf("expected center color %v, got %v", want, center)
+	}
+	// longitude increases to the right across the view
+	if left, right := view.RGBA64At(2, 10), view.RGBA64At(30, 10); left.R >= right.R {
+		t.Errorf("expected red to increase to the right, got %v and %v", left, right)
+	}
+}
@@ -0,0 +1,230 @@
+package flatsphere
+
+import "math"
+
+// A region on the unit sphere bounded by great-circle edges, with an outer boundary and optional holes. Each ring
+// is a list of latitude/longitude vertices in radians, closed implicitly by joining the last vertex to the first.
+// The interior of the polygon lies to the left of the outer ring's edges (counter-clockwise when viewed from
+// outside the sphere) and to the right of the edges of each hole (clockwise). Use NormalizeWinding to bring
+// rings drawn in either order into this convention.
+type SphericalPolygon struct {
+	Outer [][2]float64
+	Holes [][][2]float64
+}
+
+// Construct a spherical polygon from its outer ring and any holes.
+func NewSphericalPolygon(outer [][2]float64, holes ...[][2]float64) SphericalPolygon {
+	return SphericalPolygon{Outer: outer, Holes: holes}
+}
+
+// Construct a spherical polygon from rings of planar x/y vertices in the given projection, by inverting each
+// vertex onto the sphere. A straight planar edge is generally not a great circle on the sphere, so each planar
+// edge is first split into the given number of subdivisions to follow its shape more closely. Projections that
+// mirror the plane reverse the winding of rings, which NormalizeWinding can correct.
+func InversePolygon(proj Projection, subdivisions int, outer [][2]float64, holes ...[][2]float64) SphericalPolygon {
+	subdivisions = max(subdivisions, 1)
+	invertRing := func(ring [][2]float64) [][2]float64 {
+		spherical := make([][2]float64, 0, len(ring)*subdivisions)
+		for i := range ring {
+			from := ring[i]
+			to := ring[(i+1)%len(ring)]
+			for s := 0; s < subdivisions; s++ {
+				frac := float64(s) / float64(subdivisions)
+				lat, lon := proj.Inverse(from[0]+frac*(to[0]-from[0]), from[1]+frac*(to[1]-from[1]))
+				spherical = append(spherical, [2]float64{lat, lon})
+			}
+		}
+		return spherical
+	}
+
+	polygon := SphericalPolygon{Outer: invertRing(outer)}
+	for _, hole := range holes {
+		polygon.Holes = append(polygon.Holes, invertRing(hole))
+	}
+	return polygon
+}
+
+// The area of the polygon on the unit sphere, in steradians. Multiply by the square of the sphere radius to
+// get real-world areas. Polygons may contain either pole.
+func (p SphericalPolygon) Area() float64 {
+	area := ringLeftArea(p.Outer)
+	for _, hole := range p.Holes {
+		area -= 4*math.Pi - ringLeftArea(hole)
+	}
+	return area
+}
+
+// The spherical centroid of the polygon: the direction of the mean position of its area, projected back onto
+// the sphere. Returns NaN when the polygon's area is symmetric about the center of the sphere, such as a
+// hemisphere's complement or the whole sphere.
+func (p SphericalPolygon) Centroid() (lat float64, lon float64) {
+	cx, cy, cz := ringMoment(p.Outer)
+	for _, hole := range p.Holes {
+		hx, hy, hz := ringMoment(hole)
+		cx, cy, cz = cx+hx, cy+hy, cz+hz
+	}
+	if math.Sqrt(cx*cx+cy*cy+cz*cz) < 1e-15 {
+		return math.NaN(), math.NaN()
+	}
+	return cartesianToSpherical(cx, cy, cz)
+}
+
+// Determines whether the given location lies inside the polygon. Locations exactly on an edge may be
+// reported as either inside or outside.
+func (p SphericalPolygon) Contains(lat float64, lon float64) bool {
+	if !ringContains(p.Outer, lat, lon) {
+		return false
+	}
+	for _, hole := range p.Holes {
+		if !ringContains(hole, lat, lon) {
+			return false
+		}
+	}
+	return true
+}
+
+// Return a copy of the polygon with the outer ring wound counter-clockwise and holes wound clockwise,
+// assuming each ring encloses less than a hemisphere.
+func (p SphericalPolygon) NormalizeWinding() SphericalPolygon {
+	normalized := SphericalPolygon{Outer: windRing(p.Outer, true)}
+	for _, hole := range p.Holes {
+		normalized.Holes = append(normalized.Holes, windRing(hole, false))
+	}
+	return normalized
+}
+
+// The signed area in steradians enclosed by a ring on the unit sphere, positive if the ring is counter-clockwise
+// around the smaller of the two regions it bounds, and negative if clockwise. The result is in the range (-2Pi, 2Pi].
+func RingArea(ring [][2]float64) float64 {
+	if len(ring) < 3 {
+		return 0
+	}
+	sum := 0.0
+	crossings := 0
+	for i := range ring {
+		lat1, lon1 := ring[i][0], ring[i][1]
+		lat2, lon2 := ring[(i+1)%len(ring)][0], ring[(i+1)%len(ring)][1]
+		// the area of the quadrilateral between the edge and the equator
+		t1 := math.Tan(lat1 / 2)
+		t2 := math.Tan(lat2 / 2)
+		dLon := coerceAngle(lon2 - lon1)
+		sum += 2 * math.Atan2(math.Tan(dLon/2)*(t1+t2), 1+t1*t2)
+		crossings += geodTransit(degrees(lon1), degrees(lon2))
+	}
+	return reducePolygonArea(sum, 0, 4*math.Pi, crossings)
+}
+
+// The area of the region to the left of the ring, in the range [0, 4Pi).
+func ringLeftArea(ring [][2]float64) float64 {
+	area := RingArea(ring)
+	if area < 0 {
+		area += 4 * math.Pi
+	}
+	return area
+}
+
+func windRing(ring [][2]float64, counterClockwise bool) [][2]float64 {
+	wound := make([][2]float64, len(ring))
+	copy(wound, ring)
+	if (RingArea(ring) >= 0) != counterClockwise {
+		for i, j := 0, len(wound)-1; i < j; i, j = i+1, j-1 {
+			wound[i], wound[j] = wound[j], wound[i]
+		}
+	}
+	return wound
+}
+
+// The integral of position over the region left of the ring, which by Stokes' theorem is half the sum over
+// each great-circle edge of its length times its unit normal.
+func ringMoment(ring [][2]float64) (float64, float64, float64) {
+	var mx, my, mz float64
+	for i := range ring {
+		ax, ay, az := sphericalToCartesian(ring[i][0], ring[i][1])
+		bx, by, bz := sphericalToCartesian(ring[(i+1)%len(ring)][0], ring[(i+1)%len(ring)][1])
+		nx, ny, nz := cross(ax, ay, az, bx, by, bz)
+		sinTheta := math.Sqrt(nx*nx + ny*ny + nz*nz)
+		if sinTheta == 0 {
+			continue
+		}
+		theta := math.Atan2(sinTheta, ax*bx+ay*by+az*bz)
+		mx += theta * nx / sinTheta / 2
+		my += theta * ny / sinTheta / 2
+		mz += theta * nz / sinTheta / 2
+	}
+	return mx, my, mz
+}
+
+// Determines whether the location is in the region to the left of the ring, by counting crossings of the ring
+// along the arc to the location from a reference point known to be just inside the region.
+func ringContains(ring [][2]float64, lat float64, lon float64) bool {
+	if len(ring) < 3 {
+		return false
+	}
+	px, py, pz := sphericalToCartesian(lat, lon)
+	pt := [3]float64{px, py, pz}
+
+	// pick a reference point offset a little to the left of an edge midpoint, avoiding the antipode of the location
+	var ref [3]float64
+	found := false
+	for i := 0; i < len(ring) && !found; i++ {
+		ax, ay, az := sphericalToCartesian(ring[i][0], ring[i][1])
+		bx, by, bz := sphericalToCartesian(ring[(i+1)%len(ring)][0], ring[(i+1)%len(ring)][1])
+		nx, ny, nz := cross(ax, ay, az, bx, by, bz)
+		nLen := math.Sqrt(nx*nx + ny*ny + nz*nz)
+		mx, my, mz := ax+bx, ay+by, az+bz
+		mLen := math.Sqrt(mx*mx + my*my + mz*mz)
+		if nLen < 1e-12 || mLen < 1e-12 {
+			continue
+		}
+		offset := math.Min(1e-7, nLen*1e-3)
+		rx := mx/mLen + offset*nx/nLen
+		ry := my/mLen + offset*ny/nLen
+		rz := mz/mLen + offset*nz/nLen
+		rLen := math.Sqrt(rx*rx + ry*ry + rz*rz)
+		ref = [3]float64{rx / rLen, ry / rLen, rz / rLen}
+		found = dot(ref, pt) > -0.99
+	}
+	if !found {
+		return false
+	}
+
+	inside := true
+	for i := range ring {
+		ax, ay, az := sphericalToCartesian(ring[i][0], ring[i][1])
+		bx, by, bz := sphericalToCartesian(ring[(i+1)%len(ring)][0], ring[(i+1)%len(ring)][1])
+		if arcsCross(ref, pt, [3]float64{ax, ay, az}, [3]float64{bx, by, bz}) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// Determines whether the great-circle arcs a-b and c-d, each shorter than a half circle, cross. Points lying
+// exactly on the other arc are consistently treated as lying to its left, so that an arc passing through a
+// shared vertex crosses exactly one of the two edges meeting there.
+func arcsCross(a, b, c, d [3]float64) bool {
+	acb := -orientation(a, b, c)
+	bda := orientation(a, b, d)
+	if acb != bda {
+		return false
+	}
+	cbd := -orientation(c, d, b)
+	if cbd != acb {
+		return false
+	}
+	dac := orientation(c, d, a)
+	return dac == acb
+}
+
+// The side of the great circle through a and b on which c lies, as +1 (left or on it) or -1 (right).
+func orientation(a, b, c [3]float64) int {
+	nx, ny, nz := cross(a[0], a[1], a[2], b[0], b[1], b[2])
+	if nx*c[0]+ny*c[1]+nz*c[2] >= 0 {
+		return 1
+	}
+	return -1
+}
+
+func dot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
@@ -0,0 +1,118 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRingArea(t *testing.T) {
+	capLat := 80 * math.Pi / 180
+	testCases := []struct {
+		name     string
+		ring     [][2]float64
+		expected float64
+	}{
+		{"Octant", [][2]float64{{0, 0}, {0, math.Pi / 2}, {math.Pi / 2, 0}}, math.Pi / 2},
+		{"ReversedOctant", [][2]float64{{math.Pi / 2, 0}, {0, math.Pi / 2}, {0, 0}}, -math.Pi / 2},
+		{"AcrossAntimeridian", [][2]float64{{0, 3 * math.Pi / 4}, {0, -3 * math.Pi / 4}, {math.Pi / 2, 0}}, math.Pi / 2},
+		{"Degenerate", [][2]float64{{0, 0}, {0, 1}}, 0},
+		// a square around the north pole, whose great-circle edges enclose less than the 80 degree cap
+		{"NorthPole", [][2]float64{{capLat, 0}, {capLat, math.Pi / 2}, {capLat, math.Pi}, {capLat, -math.Pi / 2}}, polarSquareArea(capLat)},
+		{"SouthPole", [][2]float64{{-capLat, 0}, {-capLat, -math.Pi / 2}, {-capLat, math.Pi}, {-capLat, math.Pi / 2}}, polarSquareArea(capLat)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			area := RingArea(tc.ring)
+			if !withinTolerance(area, tc.expected, 0.000001) {
+				t.Errorf("expected area %e, got %e", tc.expected, area)
+			}
+		})
+	}
+}
+
+// The area of a square with vertices at the given latitude around a pole, as four triangles meeting at the pole.
+func polarSquareArea(lat float64) float64 {
+	// each triangle has a right angle at the pole, and two equal base angles
+	side := math.Pi/2 - lat
+	hyp := GreatCircleDistance(lat, 0, lat, math.Pi/2)
+	base := math.Asin(math.Sin(side) / math.Sin(hyp))
+	return 4 * (math.Pi/2 + 2*base - math.Pi)
+}
+
+func TestPolygonAreaWithHoles(t *testing.T) {
+	outer := [][2]float64{{0, 0}, {0, math.Pi / 2}, {math.Pi / 2, 0}}
+	hole := [][2]float64{{0.1, 0.1}, {0.2, 0.1}, {0.1, 0.2}}
+	polygon := NewSphericalPolygon(outer, hole)
+	expected := math.Pi/2 - math.Abs(RingArea(hole))
+	if !withinTolerance(polygon.Area(), expected, 0.000001) {
+		t.Errorf("expected area %e, got %e", expected, polygon.Area())
+	}
+
+	// a hole drawn counter-clockwise is fixed up by normalization
+	backwards := NewSphericalPolygon(outer, [][2]float64{{0.1, 0.1}, {0.1, 0.2}, {0.2, 0.1}}).NormalizeWinding()
+	if !withinTolerance(backwards.Area(), expected, 0.000001) {
+		t.Errorf("expected normalized area %e, got %e", expected, backwards.Area())
+	}
+}
+
+func TestPolygonCentroid(t *testing.T) {
+	octant := NewSphericalPolygon([][2]float64{{0, 0}, {0, math.Pi / 2}, {math.Pi / 2, 0}})
+	lat, lon := octant.Centroid()
+	if !withinTolerance(lat, math.Asin(1/math.Sqrt(3)), 0.000001) || !withinTolerance(lon, math.Pi/4, 0.000001) {
+		t.Errorf("expected octant centroid %e,%e, got %e,%e", math.Asin(1/math.Sqrt(3)), math.Pi/4, lat, lon)
+	}
+
+	capLat := 1.4
+	polar := NewSphericalPolygon([][2]float64{{capLat, 0}, {capLat, 2 * math.Pi / 3}, {capLat, -2 * math.Pi / 3}})
+	lat, _ = polar.Centroid()
+	if !withinTolerance(lat, math.Pi/2, 0.000001) {
+		t.Errorf("expected polar centroid at the pole, got %e", lat)
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	hole := [][2]float64{{0.1, 0.1}, {0.2, 0.1}, {0.1, 0.2}}
+	octant := NewSphericalPolygon([][2]float64{{0, 0}, {0, math.Pi / 2}, {math.Pi / 2, 0}}, hole)
+	polar := NewSphericalPolygon([][2]float64{{1.2, 0}, {1.2, 2 * math.Pi / 3}, {1.2, -2 * math.Pi / 3}})
+
+	testCases := []struct {
+		name     string
+		polygon  SphericalPolygon
+		lat      float64
+		lon      float64
+		expected bool
+	}{
+		{"OctantInside", octant, 0.5, 0.5, true},
+		{"OctantOutside", octant, -0.5, 0.5, false},
+		{"OctantAntipode", octant, -0.5, 0.5 - math.Pi, false},
+		{"OctantHole", octant, 0.12, 0.12, false},
+		{"OctantBesideEdge", octant, 0.3, -0.01, false},
+		{"PolarInside", polar, math.Pi / 2, 0, true},
+		{"PolarInsideOffPole", polar, 1.45, 3.0, true},
+		{"PolarOutside", polar, 1.0, 1.0, false},
+		{"PolarSouthPole", polar, -math.Pi / 2, 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.polygon.Contains(tc.lat, tc.lon); got != tc.expected {
+				t.Errorf("expected containment %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestInversePolygon(t *testing.T) {
+	// a planar rectangle in plate carrée spanning a quarter of the longitudes and the lowest 45 degrees of latitude
+	rect := [][2]float64{{0, 0}, {math.Pi / 2, 0}, {math.Pi / 2, math.Pi / 4}, {0, math.Pi / 4}}
+	polygon := InversePolygon(NewPlateCarree(), 64, rect)
+	// the exact area of the lat/lon rectangle is the longitude span times the difference of sines of latitude
+	expected := math.Pi / 2 * math.Sin(math.Pi/4)
+	if !withinTolerance(polygon.Area(), expected, 0.001) {
+		t.Errorf("expected area %e, got %e", expected, polygon.Area())
+	}
+	if !polygon.Contains(0.5, 0.5) || polygon.Contains(1.0, 0.5) {
+		t.Errorf("expected inverted polygon to contain only its own region")
+	}
+}
@@ -0,0 +1,766 @@
+package flatsphere
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// A convex polyhedron inscribed in the unit sphere, described by the locations (in radians) of its vertices on
+// the sphere and, for each face, the indices of the vertices around it. Faces are wound counterclockwise when
+// seen from outside the polyhedron, and are reversed on construction if need be, keeping their first vertex.
+type Polyhedron struct {
+	Vertices [][2]float64 // The latitude and longitude (in radians) of each vertex.
+	Faces    [][]int      // The indices into Vertices of the corners of each face.
+}
+
+func NewPolyhedron(vertices [][2]float64, faces [][]int) Polyhedron {
+	wound := make([][]int, len(faces))
+	for i, face := range faces {
+		wound[i] = append([]int(nil), face...)
+		a := NewVec3FromLatLon(vertices[face[0]][0], vertices[face[0]][1])
+		b := NewVec3FromLatLon(vertices[face[1]][0], vertices[face[1]][1])
+		c := NewVec3FromLatLon(vertices[face[2]][0], vertices[face[2]][1])
+		if b.Sub(a).Cross(c.Sub(a)).Dot(a) < 0 {
+			for j, k := 1, len(face)-1; j < k; j, k = j+1, k-1 {
+				wound[i][j], wound[i][k] = wound[i][k], wound[i][j]
+			}
+		}
+	}
+	return Polyhedron{Vertices: append([][2]float64(nil), vertices...), Faces: wound}
+}
+
+// The regular icosahedron with vertices at the poles. Faces 0 to 4 surround the north pole, from longitude 0
+// eastward, faces 5 to 9 lie below them, sharing an edge with the face five before, faces 10 to 14 are
+// between those, each sharing an edge with the face five before and the one four before, and faces 15 to 19
+// surround the south pole, each below the face five before.
+func NewIcosahedron() Polyhedron {
+	ringLat := math.Atan(0.5)
+	vertices := [][2]float64{{math.Pi / 2, 0}}
+	for i := 0; i < 5; i++ {
+		vertices = append(vertices, [2]float64{ringLat, coerceAngle(float64(i) * 2 * math.Pi / 5)})
+	}
+	for i := 0; i < 5; i++ {
+		vertices = append(vertices, [2]float64{-ringLat, coerceAngle((float64(i) + 0.5) * 2 * math.Pi / 5)})
+	}
+	vertices = append(vertices, [2]float64{-math.Pi / 2, 0})
+
+	faces := make([][]int, 20)
+	for i := 0; i < 5; i++ {
+		next := (i + 1) % 5
+		faces[i] = []int{0, 1 + i, 1 + next}
+		faces[5+i] = []int{1 + i, 6 + i, 1 + next}
+		faces[10+i] = []int{6 + i, 6 + next, 1 + next}
+		faces[15+i] = []int{11, 6 + next, 6 + i}
+	}
+	return NewPolyhedron(vertices, faces)
+}
+
+// The regular tetrahedron with a vertex at the north pole and the others at longitudes 0, 2Pi/3 and -2Pi/3.
+// Face 0 is centered on the south pole, and faces 1 to 3 surround the north pole, from longitude 0 eastward.
+func NewTetrahedron() Polyhedron {
+	ringLat := -math.Asin(1.0 / 3)
+	vertices := [][2]float64{{math.Pi / 2, 0}, {ringLat, 0}, {ringLat, 2 * math.Pi / 3}, {ringLat, -2 * math.Pi / 3}}
+	faces := [][]int{{1, 3, 2}, {0, 1, 2}, {0, 2, 3}, {0, 3, 1}}
+	return NewPolyhedron(vertices, faces)
+}
+
+// The regular octahedron with vertices at the poles and on the equator at longitudes 0, Pi/2, Pi and -Pi/2.
+// Faces 0 to 3 surround the north pole, from longitude 0 eastward, and faces 4 to 7 lie below them.
+func NewOctahedron() Polyhedron {
+	vertices := [][2]float64{{math.Pi / 2, 0}, {0, 0}, {0, math.Pi / 2}, {0, math.Pi}, {0, -math.Pi / 2}, {-math.Pi / 2, 0}}
+	faces := make([][]int, 8)
+	for i := 0; i < 4; i++ {
+		next := (i + 1) % 4
+		faces[i] = []int{0, 1 + i, 1 + next}
+		faces[4+i] = []int{5, 1 + next, 1 + i}
+	}
+	return NewPolyhedron(vertices, faces)
+}
+
+// The cube with faces centered on the poles and on the equator at longitudes 0, Pi/2, Pi and -Pi/2. Faces 0 to 3
+// are the equatorial faces, from longitude 0 eastward, face 4 is centered on the north pole and face 5 on
+// the south pole.
+func NewCube() Polyhedron {
+	cornerLat := math.Atan(1 / math.Sqrt2)
+	vertices := make([][2]float64, 8)
+	for i := 0; i < 4; i++ {
+		lon := coerceAngle((float64(i) - 0.5) * math.Pi / 2)
+		vertices[i] = [2]float64{cornerLat, lon}
+		vertices[4+i] = [2]float64{-cornerLat, lon}
+	}
+	faces := make([][]int, 6)
+	for i := 0; i < 4; i++ {
+		next := (i + 1) % 4
+		faces[i] = []int{i, 4 + i, 4 + next, next}
+	}
+	faces[4] = []int{0, 1, 2, 3}
+	faces[5] = []int{4, 5, 6, 7}
+	return NewPolyhedron(vertices, faces)
+}
+
+// The same polyhedron with every vertex moved by the rotation.
+func (p Polyhedron) Rotate(rotation Rotation) Polyhedron {
+	vertices := make([][2]float64, len(p.Vertices))
+	for i, vertex := range p.Vertices {
+		vertices[i][0], vertices[i][1] = rotation.Apply(vertex[0], vertex[1])
+	}
+	return Polyhedron{Vertices: vertices, Faces: p.Faces}
+}
+
+// How the part of the sphere covered by each face of a polyhedron is mapped onto the flattened face.
+type FaceProjection int
+
+const (
+	// Project from the center of the sphere onto the face, so that great circles are straight lines within each
+	// face, as in the gnomonic projection.
+	FaceGnomonic FaceProjection = iota
+	// Divide each face into triangles between its center and each edge, and map each spherical triangle onto
+	// its flat triangle preserving area, by the slice-and-dice method of van Leeuwen and Strebe (2006), which
+	// matches Snyder's equal-area polyhedral projections for regular polyhedra.
+	FaceEqualArea
+	// Map each face conformally onto its flat face, with the corners of the face as the only points of angular
+	// distortion, as in Lee's conformal tetrahedral projection. The faces must be the equilateral triangles of a
+	// regular tetrahedron, octahedron or icosahedron. The map of each face is the composition of the Schwarz
+	// triangle function for the polyhedron's symmetry group, a cube root of Klein's invariant in a stereographic
+	// coordinate centered on the face, with the integral of 1/√(1 - t³), which unfolds it onto the triangle.
+	// See Lee (1976), "Conformal projections based on elliptic functions".
+	FaceConformal
+)
+
+// A projection which maps the sphere onto the faces of a polyhedron, then unfolds the faces into a flat net.
+// The net is described by a tree of faces, where each face is hinged to its parent face along the edge they
+// share, and the root face is centered on the origin. Planar coordinates are scaled so that the faces have the
+// same total area as the unit sphere.
+type PolyhedralProjection struct {
+	polyhedron     Polyhedron
+	faceProjection FaceProjection
+	parents        []int
+	faces          []polyhedralFace
+	name           string
+}
+
+// The geometry of one face on the sphere and in the net.
+type polyhedralFace struct {
+	vertices  []Vec3       // The corners of the face on the unit sphere.
+	center    Vec3         // The center of the face on the unit sphere.
+	depth     float64      // The distance from the center of the sphere to the plane of the face.
+	net       [][2]float64 // The corners of the face in the net.
+	netCenter [2]float64   // The center of the face in the net.
+}
+
+// Construct a projection onto the faces of a polyhedron, unfolded into a net where each face is hinged to the
+// face given by parents at the same index, or is the root of the net where that is negative. There must be
+// exactly one root, and every other face must share an edge with its parent. The first vertex of the root
+// face lies in the direction of the given angle (in radians counterclockwise from the positive x axis) from
+// the origin. The equal-area face projection requires the faces to be regular polygons of the same size, and
+// the conformal face projection requires a regular polyhedron with triangular faces.
+func NewPolyhedralProjection(polyhedron Polyhedron, faceProjection FaceProjection, parents []int, rootAngle float64) PolyhedralProjection {
+	if len(parents) != len(polyhedron.Faces) {
+		panic("parents must have one entry for each face of the polyhedron")
+	}
+	if faceProjection == FaceConformal {
+		for _, face := range polyhedron.Faces {
+			if len(face) != 3 {
+				panic("the conformal face projection requires a polyhedron with triangular faces")
+			}
+		}
+	}
+	roots := 0
+	for _, parent := range parents {
+		if parent < 0 {
+			roots++
+		}
+	}
+	if roots != 1 {
+		panic("the parents of a polyhedral net must form a tree with a single root")
+	}
+	faces := make([]polyhedralFace, len(polyhedron.Faces))
+	for i, indices := range polyhedron.Faces {
+		face := polyhedralFace{vertices: make([]Vec3, len(indices)), net: make([][2]float64, len(indices))}
+		var sum Vec3
+		for j, index := range indices {
+			face.vertices[j] = NewVec3FromLatLon(polyhedron.Vertices[index][0], polyhedron.Vertices[index][1])
+			sum = sum.Add(face.vertices[j])
+		}
+		face.center = sum.Normalize()
+		face.depth = face.vertices[0].Dot(face.center)
+		faces[i] = face
+	}
+
+	// scale the net so that the flattened faces have the same area as their spherical faces, which is needed for
+	// an equal-area projection, and keeps other projections to a similar size
+	flatArea, sphericalArea := 0.0, 0.0
+	for _, face := range faces {
+		for j := range face.vertices {
+			a := face.center.Scale(face.depth)
+			b, c := face.vertices[j], face.vertices[(j+1)%len(face.vertices)]
+			flatArea += b.Sub(a).Cross(c.Sub(a)).Norm() / 2
+			sphericalArea += sphericalTriangleArea(face.center, b, c)
+		}
+	}
+	scale := math.Sqrt(sphericalArea / flatArea)
+
+	// place the faces in the net from the root outward
+	placed := make([]bool, len(faces))
+	for remaining := len(faces); remaining > 0; {
+		progress := false
+		for i := range faces {
+			if placed[i] || (parents[i] >= 0 && !placed[parents[i]]) {
+				continue
+			}
+			local := faces[i].local(scale)
+			if parents[i] < 0 {
+				sin, cos := math.Sincos(rootAngle)
+				for j := range local {
+					faces[i].net[j] = [2]float64{cos*local[j][0] - sin*local[j][1], sin*local[j][0] + cos*local[j][1]}
+				}
+			} else {
+				parent := parents[i]
+				j, k, ok := sharedEdge(polyhedron.Faces[parent], polyhedron.Faces[i])
+				if !ok {
+					panic("each face must share an edge with its parent in a polyhedral net")
+				}
+				// the edge runs from j to k in the child, and the other way around in the parent
+				from, to := faces[parent].net[indexOf(polyhedron.Faces[parent], polyhedron.Faces[i][j])],
+					faces[parent].net[indexOf(polyhedron.Faces[parent], polyhedron.Faces[i][k])]
+				angle := math.Atan2(to[1]-from[1], to[0]-from[0]) - math.Atan2(local[k][1]-local[j][1], local[k][0]-local[j][0])
+				sin, cos := math.Sincos(angle)
+				for m := range local {
+					dx, dy := local[m][0]-local[j][0], local[m][1]-local[j][1]
+					faces[i].net[m] = [2]float64{from[0] + cos*dx - sin*dy, from[1] + sin*dx + cos*dy}
+				}
+			}
+			for _, corner := range faces[i].net {
+				faces[i].netCenter[0] += corner[0] / float64(len(faces[i].net))
+				faces[i].netCenter[1] += corner[1] / float64(len(faces[i].net))
+			}
+			placed[i] = true
+			remaining--
+			progress = true
+		}
+		if !progress {
+			panic("the parents of a polyhedral net must form a tree with a single root")
+		}
+	}
+
+	return PolyhedralProjection{
+		polyhedron:     polyhedron,
+		faceProjection: faceProjection,
+		parents:        append([]int(nil), parents...),
+		faces:          faces,
+	}
+}
+
+// The corners of the flattened face, scaled, in a frame centered on the face with the first corner along the x
+// axis and the corners counterclockwise.
+func (f polyhedralFace) local(scale float64) [][2]float64 {
+	origin := f.center.Scale(f.depth)
+	xAxis := f.vertices[0].Sub(origin).Normalize()
+	yAxis := f.center.Cross(xAxis)
+	local := make([][2]float64, len(f.vertices))
+	for i, vertex := range f.vertices {
+		offset := vertex.Sub(origin).Scale(scale)
+		local[i] = [2]float64{offset.Dot(xAxis), offset.Dot(yAxis)}
+	}
+	return local
+}
+
+// The positions in the second face of the first edge shared by both faces.
+func sharedEdge(first []int, second []int) (int, int, bool) {
+	for j := range second {
+		k := (j + 1) % len(second)
+		for m := range first {
+			if first[m] == second[k] && first[(m+1)%len(first)] == second[j] {
+				return j, k, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+func indexOf(values []int, value int) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// The area of the spherical triangle on the unit sphere with the given corners, following Eriksson (1990).
+func sphericalTriangleArea(a Vec3, b Vec3, c Vec3) float64 {
+	return 2 * math.Abs(math.Atan2(a.Dot(b.Cross(c)), 1+a.Dot(b)+b.Dot(c)+c.Dot(a)))
+}
+
+// The polyhedron the sphere is projected onto.
+func (p PolyhedralProjection) Polyhedron() Polyhedron {
+	return p.polyhedron
+}
+
+// The corners of each face in the net, in the same order as the faces of the polyhedron.
+func (p PolyhedralProjection) Net() [][][2]float64 {
+	net := make([][][2]float64, len(p.faces))
+	for i, face := range p.faces {
+		net[i] = append([][2]float64(nil), face.net...)
+	}
+	return net
+}
+
+func (p PolyhedralProjection) Project(lat float64, lon float64) (float64, float64) {
+	point := NewVec3FromLatLon(lat, lon)
+	// the face whose center is closest, and the triangle of the face between its center and an edge
+	best := 0
+	for i, face := range p.faces {
+		if point.Dot(face.center) > point.Dot(p.faces[best].center) {
+			best = i
+		}
+	}
+	face := p.faces[best]
+	edge, bestSide := 0, math.Inf(-1)
+	for j := range face.vertices {
+		b, c := face.vertices[j], face.vertices[(j+1)%len(face.vertices)]
+		side := math.Min(face.center.Cross(b).Dot(point), c.Cross(face.center).Dot(point))
+		if side > bestSide {
+			edge, bestSide = j, side
+		}
+	}
+	next := (edge + 1) % len(face.vertices)
+	return p.projectTriangle(face.center, face.vertices[edge], face.vertices[next], face.depth,
+		face.netCenter, face.net[edge], face.net[next], point)
+}
+
+// Map a point within the spherical triangle abc, where a is the center of a face at the given depth, onto the
+// flat triangle with corners at the net positions na, nb and nc.
+func (p PolyhedralProjection) projectTriangle(a Vec3, b Vec3, c Vec3, depth float64, na [2]float64, nb [2]float64, nc [2]float64, point Vec3) (float64, float64) {
+	if p.faceProjection == FaceGnomonic {
+		onPlane := point.Scale(depth / point.Dot(a))
+		u, v := barycentric3(a.Scale(depth), b, c, onPlane)
+		return na[0] + u*(nb[0]-na[0]) + v*(nc[0]-na[0]), na[1] + u*(nb[1]-na[1]) + v*(nc[1]-na[1])
+	}
+	if p.faceProjection == FaceConformal {
+		// the corners are singular, and the integral only slowly approaches them
+		if 1-point.Dot(b) < 1e-15 {
+			return nb[0], nb[1]
+		}
+		if 1-point.Dot(c) < 1e-15 {
+			return nc[0], nc[1]
+		}
+		f := p.conformalFace(a, b, c)
+		// the map is symmetric about the real axis, so keep the side of the real axis exactly
+		zeta := f.stereographic(point)
+		t, _ := f.flat(complex(real(zeta), math.Abs(imag(zeta))))
+		t = complex(real(t), math.Copysign(imag(t), imag(zeta)))
+		tb, tc := f.corner(b), f.corner(c)
+		u, v := barycentric2([2]float64{0, 0}, [2]float64{real(tb), imag(tb)}, [2]float64{real(tc), imag(tc)}, real(t), imag(t))
+		return na[0] + u*(nb[0]-na[0]) + v*(nc[0]-na[0]), na[1] + u*(nb[1]-na[1]) + v*(nc[1]-na[1])
+	}
+
+	if 1-point.Dot(a) < 1e-15 {
+		return na[0], na[1]
+	}
+	// the point where the great circle from the center through the point meets the edge
+	d := a.Cross(point).Cross(b.Cross(c)).Normalize()
+	if d.Dot(b.Add(c)) < 0 {
+		d = d.Scale(-1)
+	}
+	// slice the flat triangle so the area to the side of b matches, then place the point along the slice
+	fraction := sphericalTriangleArea(a, b, d) / sphericalTriangleArea(a, b, c)
+	nd := [2]float64{nb[0] + fraction*(nc[0]-nb[0]), nb[1] + fraction*(nc[1]-nb[1])}
+	t := math.Sqrt((1 - point.Dot(a)) / (1 - d.Dot(a)))
+	return na[0] + t*(nd[0]-na[0]), na[1] + t*(nd[1]-na[1])
+}
+
+func (p PolyhedralProjection) Inverse(x float64, y float64) (float64, float64) {
+	// the triangle of the net, between a face center and an edge, which best contains the point
+	bestFace, bestEdge, bestInside := -1, 0, math.Inf(-1)
+	for i, face := range p.faces {
+		for j := range face.net {
+			u, v := barycentric2(face.netCenter, face.net[j], face.net[(j+1)%len(face.net)], x, y)
+			inside := math.Min(1-u-v, math.Min(u, v))
+			if inside > bestInside {
+				bestFace, bestEdge, bestInside = i, j, inside
+			}
+		}
+	}
+	if bestInside < -1e-9 {
+		return math.NaN(), math.NaN()
+	}
+	face := p.faces[bestFace]
+	next := (bestEdge + 1) % len(face.vertices)
+	return p.inverseTriangle(face.center, face.vertices[bestEdge], face.vertices[next], face.depth,
+		face.netCenter, face.net[bestEdge], face.net[next], x, y).LatLon()
+}
+
+// The reverse of projectTriangle.
+func (p PolyhedralProjection) inverseTriangle(a Vec3, b Vec3, c Vec3, depth float64, na [2]float64, nb [2]float64, nc [2]float64, x float64, y float64) Vec3 {
+	if p.faceProjection == FaceGnomonic {
+		u, v := barycentric2(na, nb, nc, x, y)
+		flat := a.Scale(depth)
+		return flat.Add(b.Sub(flat).Scale(u)).Add(c.Sub(flat).Scale(v)).Normalize()
+	}
+	if p.faceProjection == FaceConformal {
+		f := p.conformalFace(a, b, c)
+		u, v := barycentric2(na, nb, nc, x, y)
+		tb, tc := f.corner(b), f.corner(c)
+		target := complex(u, 0)*tb + complex(v, 0)*tc
+		if cmplx.Abs(target-tb) < 1e-12 {
+			return b
+		}
+		if cmplx.Abs(target-tc) < 1e-12 {
+			return c
+		}
+		// Newton's method, starting from the gnomonic face projection, which is close, and halving the steps
+		// which overshoot, as they do near the singular corners, or which pass the corners, the farthest points
+		// of the face from its center, beyond which the map can come spuriously nearer the target
+		flat := a.Scale(depth)
+		zeta := f.stereographic(flat.Add(b.Sub(flat).Scale(u)).Add(c.Sub(flat).Scale(v)).Normalize())
+		t, dt := f.flat(zeta)
+		residual := cmplx.Abs(t - target)
+		reach := cmplx.Abs(f.stereographic(b))
+		for i := 0; i < 100 && residual > 0; i++ {
+			step := (t - target) / dt
+			improved := false
+			for j := 0; j < 50 && !improved; j++ {
+				next := zeta - step
+				step /= 2
+				if cmplx.Abs(next) > reach {
+					continue
+				}
+				nextT, nextDt := f.flat(next)
+				if nextResidual := cmplx.Abs(nextT - target); nextResidual < residual {
+					zeta, t, dt, residual, improved = next, nextT, nextDt, nextResidual, true
+				}
+			}
+			if !improved {
+				break
+			}
+		}
+		return f.sphere(zeta)
+	}
+
+	dx, dy := x-na[0], y-na[1]
+	if math.Hypot(dx, dy) < 1e-15 {
+		return a
+	}
+	// the slice through the point, from the center to the edge
+	ex, ey := nc[0]-nb[0], nc[1]-nb[1]
+	denominator := ex*dy - ey*dx
+	fraction := ((na[0]-nb[0])*dy - (na[1]-nb[1])*dx) / denominator
+	// the multiple of the offset to the point which reaches the edge
+	reach := (ex*(nb[1]-na[1]) - ey*(nb[0]-na[0])) / denominator
+	fraction = math.Max(0, math.Min(1, fraction))
+
+	// find the point on the edge cutting off the same fraction of the spherical triangle
+	target := fraction * sphericalTriangleArea(a, b, c)
+	low, high := 0.0, 1.0
+	for i := 0; i < 60; i++ {
+		mid := (low + high) / 2
+		if sphericalTriangleArea(a, b, b.Scale(1-mid).Add(c.Scale(mid)).Normalize()) < target {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	d := b.Scale(1 - low).Add(c.Scale(low)).Normalize()
+
+	cosDistance := clampUnit(1 - (1-d.Dot(a))/(reach*reach))
+	toward := d.Sub(a.Scale(a.Dot(d))).Normalize()
+	sinDistance := math.Sqrt(1 - cosDistance*cosDistance)
+	return a.Scale(cosDistance).Add(toward.Scale(sinDistance))
+}
+
+// A triangular face prepared for the conformal face projection, in the stereographic coordinate ζ centered on
+// the face, with the real axis toward the middle of one of its edges.
+type conformalFace struct {
+	center     Vec3
+	xAxis      Vec3
+	yAxis      Vec3
+	edgeNormal Vec3         // The normal to the plane of the edge, toward the face.
+	zeros      []complex128 // The centers of the other faces, except one opposite, where the Schwarz function is zero.
+	poles      []complex128 // The vertices, except one opposite, where the Schwarz function is infinite.
+	middles    []complex128 // The middles of the edges, where the Schwarz function is a cube root of unity.
+	exponent   float64      // The number of faces around each vertex, over three.
+	scale      complex128   // The factor making the Schwarz function one at the middle of the edge.
+}
+
+// Prepare the face centered on a for the conformal face projection, with the real axis toward the middle of the
+// edge from b to c.
+func (p PolyhedralProjection) conformalFace(a Vec3, b Vec3, c Vec3) conformalFace {
+	middle := b.Add(c).Normalize()
+	xAxis := middle.Sub(a.Scale(a.Dot(middle))).Normalize()
+	f := conformalFace{center: a, xAxis: xAxis, yAxis: a.Cross(xAxis), edgeNormal: b.Cross(c), scale: 1}
+	if f.edgeNormal.Dot(a) < 0 {
+		f.edgeNormal = f.edgeNormal.Scale(-1)
+	}
+	for _, face := range p.faces {
+		if math.Abs(face.center.Dot(a)) < 1-1e-12 {
+			f.zeros = append(f.zeros, f.stereographic(face.center))
+		}
+	}
+	around := 0
+	for _, face := range p.polyhedron.Faces {
+		if indexOf(face, 0) >= 0 {
+			around++
+		}
+	}
+	f.exponent = float64(around) / 3
+	for _, vertex := range p.polyhedron.Vertices {
+		v := NewVec3FromLatLon(vertex[0], vertex[1])
+		if v.Dot(a) > -1+1e-12 {
+			f.poles = append(f.poles, f.stereographic(v))
+		}
+	}
+	for _, face := range p.polyhedron.Faces {
+		for j, first := range face {
+			// each edge once, from the face listing its vertices in increasing order
+			if second := face[(j+1)%len(face)]; first < second {
+				v, w := p.polyhedron.Vertices[first], p.polyhedron.Vertices[second]
+				m := NewVec3FromLatLon(v[0], v[1]).Add(NewVec3FromLatLon(w[0], w[1])).Normalize()
+				if m.Dot(a) > -1+1e-12 {
+					f.middles = append(f.middles, f.stereographic(m))
+				}
+			}
+		}
+	}
+	u, _ := f.schwarz(f.stereographic(middle))
+	f.scale = 1 / u
+	return f
+}
+
+// The stereographic coordinate of a point on the unit sphere.
+func (f conformalFace) stereographic(point Vec3) complex128 {
+	return complex(point.Dot(f.xAxis), point.Dot(f.yAxis)) / complex(1+point.Dot(f.center), 0)
+}
+
+// The point on the unit sphere with the stereographic coordinate, the reverse of stereographic.
+func (f conformalFace) sphere(zeta complex128) Vec3 {
+	r2 := real(zeta)*real(zeta) + imag(zeta)*imag(zeta)
+	return f.center.Scale((1 - r2) / (1 + r2)).Add(f.xAxis.Scale(2 * real(zeta) / (1 + r2))).Add(f.yAxis.Scale(2 * imag(zeta) / (1 + r2)))
+}
+
+// The Schwarz function of the face and its derivative, the cube root of Klein's invariant for the symmetry
+// group of the polyhedron, with zeros at the face centers and poles at the vertices. It maps the face onto the
+// plane cut along the rays outward from the cube roots of unity, which are the middles of the edges.
+func (f conformalFace) schwarz(zeta complex128) (complex128, complex128) {
+	if zeta == 0 {
+		return 0, f.scale
+	}
+	u, logDerivative := f.scale*zeta, 1/zeta
+	for _, z := range f.zeros {
+		u *= 1 - zeta/z
+		logDerivative += 1 / (zeta - z)
+	}
+	// the powers of the factors for the vertices are continuous within the face, keeping to principal logarithms
+	var logDenominator complex128
+	for _, v := range f.poles {
+		logDenominator += cmplx.Log(1 - zeta/v)
+		logDerivative -= complex(f.exponent, 0) / (zeta - v)
+	}
+	u *= cmplx.Exp(-complex(f.exponent, 0) * logDenominator)
+	return u, u * logDerivative
+}
+
+// One minus the cube of the Schwarz function, from its double zeros at the middles of the edges and its poles
+// at the vertices. Near the middles, where the Schwarz function is close to a cube root of unity, this keeps the
+// relative precision that subtracting the cube from one would lose.
+func (f conformalFace) complement(zeta complex128) complex128 {
+	w := complex(1, 0)
+	for _, m := range f.middles {
+		factor := 1 - zeta/m
+		w *= factor * factor
+	}
+	// three times the exponent is a whole number, so the powers of the vertex factors need no branch
+	power := int(math.Round(3 * f.exponent))
+	for _, v := range f.poles {
+		factor := 1 - zeta/v
+		for k := 0; k < power; k++ {
+			w /= factor
+		}
+	}
+	return w
+}
+
+// The position of a point with the stereographic coordinate on the flat face, scaled to the triangle of
+// triangleIntegral, and its derivative.
+func (f conformalFace) flat(zeta complex128) (complex128, complex128) {
+	u, du := f.schwarz(zeta)
+	// the Schwarz function maps each half of the face, either side of the real axis, onto a half plane, and the
+	// edge onto the cut beyond one, so beyond the edge, or where rounding has crossed the cut on the edge, reflect
+	// the integral across the edge of the triangle, continuing the map analytically
+	side := f.sphere(zeta).Dot(f.edgeNormal)
+	if side < -1e-12 || (side < 1e-12 && real(u) > 1 && math.Signbit(imag(u)) != math.Signbit(imag(zeta))) {
+		return complex(triangleIntegralCorner(), 0) - triangleIntegral(u, f.complement(zeta)), -triangleIntegralDerivative(u) * du
+	}
+	return triangleIntegral(u, f.complement(zeta)), triangleIntegralDerivative(u) * du
+}
+
+// The position of a vertex of the face on the triangle of triangleIntegral.
+func (f conformalFace) corner(vertex Vec3) complex128 {
+	zeta := f.stereographic(vertex)
+	return complex(triangleIntegralCorner(), 0) * zeta / complex(cmplx.Abs(zeta), 0)
+}
+
+// The weights u and v of b and c, relative to a, such that p = a + u (b - a) + v (c - a), for points on a plane.
+func barycentric3(a Vec3, b Vec3, c Vec3, p Vec3) (float64, float64) {
+	v0, v1, v2 := b.Sub(a), c.Sub(a), p.Sub(a)
+	d00, d01, d11 := v0.Dot(v0), v0.Dot(v1), v1.Dot(v1)
+	d20, d21 := v2.Dot(v0), v2.Dot(v1)
+	denominator := d00*d11 - d01*d01
+	return (d11*d20 - d01*d21) / denominator, (d00*d21 - d01*d20) / denominator
+}
+
+// The weights u and v of b and c, relative to a, such that (x, y) = a + u (b - a) + v (c - a).
+func barycentric2(a [2]float64, b [2]float64, c [2]float64, x float64, y float64) (float64, float64) {
+	bx, by := b[0]-a[0], b[1]-a[1]
+	cx, cy := c[0]-a[0], c[1]-a[1]
+	px, py := x-a[0], y-a[1]
+	denominator := bx*cy - by*cx
+	return (px*cy - py*cx) / denominator, (bx*py - by*px) / denominator
+}
+
+func (p PolyhedralProjection) PlanarBounds() Bounds {
+	return NewPolygonBounds(p.Net()...)
+}
+
+func (p PolyhedralProjection) Describe() Metadata {
+	name := p.name
+	if name == "" {
+		name = "Polyhedral"
+	}
+	properties := Properties(0)
+	switch p.faceProjection {
+	case FaceEqualArea:
+		properties = EqualArea
+	case FaceConformal:
+		properties = Conformal
+	}
+	return Metadata{
+		Name:       name,
+		Family:     FamilyPolyhedral,
+		Properties: properties,
+		Shape:      ShapeOther,
+		Extent:     ExtentWorld,
+	}
+}
+
+// Snyder's equal-area projection onto an icosahedron with vertices at the poles, unfolded into the common net of
+// a zigzag strip of ten faces around the equator, with five faces above and below.
+// See Snyder (1992), "An equal-area map projection for polyhedral globes".
+func NewSnyderIcosahedral() PolyhedralProjection {
+	parents := make([]int, 20)
+	for i := 0; i < 5; i++ {
+		parents[i] = 5 + i
+		parents[5+i] = 10 + i - 1
+		parents[10+i] = 5 + i
+		parents[15+i] = 10 + i
+	}
+	parents[5] = -1
+	proj := NewPolyhedralProjection(NewIcosahedron(), FaceEqualArea, parents, 5*math.Pi/6)
+	proj.name = "Snyder equal-area icosahedral"
+	return proj
+}
+
+// An equal-area projection onto a cube with faces centered on the poles and the equator, unfolded into a cross
+// with the faces centered on longitudes -Pi/2, 0, Pi/2 and Pi in a row, and the polar faces above and below
+// longitude 0. Similar to the quadrilateralized spherical cube used for sky surveys, and to Snyder's equal-area
+// cube, which divide each face into the same four triangles.
+func NewQuadrilateralizedCube() PolyhedralProjection {
+	proj := NewPolyhedralProjection(NewCube(), FaceEqualArea, []int{-1, 0, 1, 0, 0, 0}, 3*math.Pi/4)
+	proj.name = "Quadrilateralized spherical cube"
+	return proj
+}
+
+// Lee's conformal projection onto a tetrahedron, unfolded into a triangle with the face centered on the south
+// pole in the middle, and the north pole at the three corners. The vertices around the south pole are at
+// longitudes 30 degrees west, 90 degrees east and 150 degrees west, so that the cuts from them to the north pole
+// mostly cross the oceans.
+// See Lee (1965), "Some conformal projections based on elliptic functions".
+func NewLeeTetrahedral() PolyhedralProjection {
+	proj := NewPolyhedralProjection(leeTetrahedron(), FaceConformal, []int{-1, 0, 0, 0}, -math.Pi/2)
+	proj.name = "Lee conformal tetrahedral"
+	return proj
+}
+
+func leeTetrahedron() Polyhedron {
+	return NewTetrahedron().Rotate(rotationAboutZ(radians(-30)))
+}
+
+// A conformal projection onto an icosahedron with vertices at the poles, unfolded into the same net as
+// NewSnyderIcosahedral, and like Lee's conformal maps onto the other regular polyhedra.
+func NewConformalIcosahedral() PolyhedralProjection {
+	proj := NewSnyderIcosahedral()
+	proj.faceProjection = FaceConformal
+	proj.name = "Conformal icosahedral"
+	return proj
+}
+
+// Lee's conformal projection onto a tetrahedron, unfolded into a rectangle twice as wide as the edge of the
+// tetrahedron. The tetrahedron is positioned as in NewLeeTetrahedral, and unfolded into a strip of its four
+// faces, the face on the south pole second, and the end of the strip is moved to the other end to square it
+// off, which is continuous since both ends of the strip are the same edge.
+type LeeTetrahedralRectangle struct {
+	strip   PolyhedralProjection
+	cut     float64 // The left side of the rectangle in the planar coordinates of the strip.
+	period  float64 // The distance between the ends of the strip.
+	xOffset float64
+	yOffset float64
+	height  float64
+}
+
+func NewLeeTetrahedralRectangle() LeeTetrahedralRectangle {
+	// the strip of faces 1, 0, 2 and 3, turned to run along the x axis
+	strip := NewPolyhedralProjection(leeTetrahedron(), FaceConformal, []int{-1, 0, 0, 2}, -5*math.Pi/6)
+	// both ends of the strip are the edge between the north pole and vertex 1
+	start, end := strip.faces[1].net, strip.faces[3].net
+	yMin, yMax := math.Inf(1), math.Inf(-1)
+	for _, face := range strip.faces {
+		for _, corner := range face.net {
+			yMin, yMax = math.Min(yMin, corner[1]), math.Max(yMax, corner[1])
+		}
+	}
+	cut := math.Max(start[0][0], start[1][0])
+	period := end[0][0] - start[0][0]
+	return LeeTetrahedralRectangle{
+		strip:   strip,
+		cut:     cut,
+		period:  period,
+		xOffset: cut + period/2,
+		yOffset: (yMin + yMax) / 2,
+		height:  yMax - yMin,
+	}
+}
+
+func (l LeeTetrahedralRectangle) Project(lat float64, lon float64) (float64, float64) {
+	x, y := l.strip.Project(lat, lon)
+	if x < l.cut {
+		x += l.period
+	}
+	// keep the edges of the strip on the rectangle despite rounding
+	x = math.Max(-l.period/2, math.Min(l.period/2, x-l.xOffset))
+	y = math.Max(-l.height/2, math.Min(l.height/2, y-l.yOffset))
+	return x, y
+}
+
+func (l LeeTetrahedralRectangle) Inverse(x float64, y float64) (float64, float64) {
+	x, y = x+l.xOffset, y+l.yOffset
+	lat, lon := l.strip.Inverse(x, y)
+	if math.IsNaN(lat) {
+		// beyond the end of the strip, in the part moved from its start
+		return l.strip.Inverse(x-l.period, y)
+	}
+	return lat, lon
+}
+
+func (l LeeTetrahedralRectangle) PlanarBounds() Bounds {
+	return NewRectangleBounds(l.period, l.height)
+}
+
+func (l LeeTetrahedralRectangle) Describe() Metadata {
+	return Metadata{
+		Name:       "Lee conformal tetrahedral rectangle",
+		Family:     FamilyPolyhedral,
+		Properties: Conformal,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentWorld,
+	}
+}
@@ -0,0 +1,205 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPolyhedronWinding(t *testing.T) {
+	for _, polyhedron := range []Polyhedron{NewTetrahedron(), NewIcosahedron(), NewOctahedron(), NewCube()} {
+		for _, face := range polyhedron.Faces {
+			a := NewVec3FromLatLon(polyhedron.Vertices[face[0]][0], polyhedron.Vertices[face[0]][1])
+			b := NewVec3FromLatLon(polyhedron.Vertices[face[1]][0], polyhedron.Vertices[face[1]][1])
+			c := NewVec3FromLatLon(polyhedron.Vertices[face[2]][0], polyhedron.Vertices[face[2]][1])
+			if b.Sub(a).Cross(c.Sub(a)).Dot(a) <= 0 {
+				t.Errorf("expected face %v to be wound counterclockwise from outside", face)
+			}
+		}
+	}
+}
+
+func TestPolyhedralEqualArea(t *testing.T) {
+	for _, proj := range []PolyhedralProjection{NewSnyderIcosahedral(), NewQuadrilateralizedCube()} {
+		for lat := -80.5; lat < 80; lat += 7.3 {
+			for lon := -179.7; lon < 180; lon += 11.3 {
+				if area := AreaDistortionAt(proj, lat*math.Pi/180, lon*math.Pi/180); !withinTolerance(area, 0, 0.00001) {
+					t.Errorf("expected no area distortion for %s at %f,%f, got %e", proj.Describe().Name, lat, lon, area)
+				}
+			}
+		}
+	}
+}
+
+func TestPolyhedralProjectInverse(t *testing.T) {
+	for _, proj := range []PolyhedralProjection{NewSnyderIcosahedral(), NewQuadrilateralizedCube(), NewLeeTetrahedral(), NewConformalIcosahedral()} {
+		for lat := -89.5; lat < 90; lat += 4.9 {
+			for lon := -180.0; lon <= 180; lon += 6.1 {
+				x, y := proj.Project(lat*math.Pi/180, lon*math.Pi/180)
+				rlat, rlon := proj.Inverse(x, y)
+				// compare by distance, since the cube's back face is centered on the antimeridian
+				if distance := GreatCircleDistance(lat*math.Pi/180, lon*math.Pi/180, rlat, rlon); distance > 1e-9 {
+					t.Errorf("expected %s to invert %f,%f, got %f,%f", proj.Describe().Name, lat, lon, rlat*180/math.Pi, rlon*180/math.Pi)
+				}
+			}
+		}
+	}
+}
+
+func TestPolyhedralConformal(t *testing.T) {
+	octahedral := NewPolyhedralProjection(NewOctahedron(), FaceConformal, []int{1, -1, 1, 2, 0, 1, 2, 3}, math.Pi/3)
+	for _, proj := range []interface {
+		Projection
+		Describer
+	}{NewLeeTetrahedral(), NewLeeTetrahedralRectangle(), NewConformalIcosahedral(), octahedral} {
+		vertices := leeTetrahedron().Vertices
+		if polyhedral, ok := proj.(PolyhedralProjection); ok {
+			vertices = polyhedral.Polyhedron().Vertices
+		}
+		for lat := -80.5; lat < 80; lat += 7.3 {
+			for lon := -179.7; lon < 180; lon += 11.3 {
+				// the vertices are the only singular points
+				nearVertex := false
+				for _, vertex := range vertices {
+					nearVertex = nearVertex || GreatCircleDistance(lat*math.Pi/180, lon*math.Pi/180, vertex[0], vertex[1]) < 0.1
+				}
+				// the finite differences of the distortion leave some error
+				if angular := AngularDistortionAt(proj, lat*math.Pi/180, lon*math.Pi/180); !nearVertex && !withinTolerance(angular, 0, 0.00001) {
+					t.Errorf("expected no angular distortion for %s at %f,%f, got %e", proj.Describe().Name, lat, lon, angular)
+				}
+			}
+		}
+	}
+}
+
+func TestLeeTetrahedral(t *testing.T) {
+	proj := NewLeeTetrahedral()
+	// the south pole is in the middle, and the north pole at the corners
+	if x, y := proj.Project(-math.Pi/2, 0); !withinTolerance(x, 0, 1e-12) || !withinTolerance(y, 0, 1e-12) {
+		t.Errorf("expected the south pole at the origin, got %e,%e", x, y)
+	}
+	bounds := proj.PlanarBounds()
+	side := bounds.Width()
+	if !withinTolerance(side*side*math.Sqrt(3)/4, 4*math.Pi, 1e-9) || !withinTolerance(bounds.Height(), side*math.Sqrt(3)/2, 1e-9) {
+		t.Errorf("expected an equilateral triangle with the area of the sphere, got %f by %f", bounds.Width(), bounds.Height())
+	}
+	if x, y := proj.Project(math.Pi/2, 0); !withinTolerance(math.Hypot(x, y), side/math.Sqrt(3), 1e-9) {
+		t.Errorf("expected the north pole at a corner, got %f,%f", x, y)
+	}
+}
+
+func TestLeeTetrahedralRectangle(t *testing.T) {
+	proj := NewLeeTetrahedralRectangle()
+	bounds := proj.PlanarBounds()
+	if !withinTolerance(bounds.Width()*bounds.Height(), 4*math.Pi, 1e-9) || !withinTolerance(bounds.Width()/bounds.Height(), 4/math.Sqrt(3), 1e-9) {
+		t.Errorf("expected a rectangle with the area of the sphere, twice as wide as the edge, got %f by %f", bounds.Width(), bounds.Height())
+	}
+	for lat := -89.5; lat < 90; lat += 4.9 {
+		for lon := -180.0; lon <= 180; lon += 6.1 {
+			x, y := proj.Project(lat*math.Pi/180, lon*math.Pi/180)
+			if !bounds.Within(x, y) {
+				t.Errorf("expected %f,%f to be within the rectangle, got %f,%f", lat, lon, x, y)
+			}
+			rlat, rlon := proj.Inverse(x, y)
+			if distance := GreatCircleDistance(lat*math.Pi/180, lon*math.Pi/180, rlat, rlon); distance > 1e-9 {
+				t.Errorf("expected %f,%f to invert, got %f,%f", lat, lon, rlat*180/math.Pi, rlon*180/math.Pi)
+			}
+		}
+	}
+}
+
+func TestPolyhedralGnomonicGreatCircles(t *testing.T) {
+	proj := NewPolyhedralProjection(NewIcosahedron(), FaceGnomonic, NewSnyderIcosahedral().parents, 0)
+	// a great circle arc within the face north of longitude 36 degrees
+	path := GreatCirclePath(0.8, 0.3, 0.6, 0.9, 5)
+	x0, y0 := proj.Project(path[0][0], path[0][1])
+	x1, y1 := proj.Project(path[len(path)-1][0], path[len(path)-1][1])
+	for _, loc := range path {
+		x, y := proj.Project(loc[0], loc[1])
+		if offLine := (x1-x0)*(y-y0) - (y1-y0)*(x-x0); !withinTolerance(offLine, 0, 1e-12) {
+			t.Errorf("expected %f,%f to be on a straight line, got offset %e", loc[0], loc[1], offLine)
+		}
+	}
+}
+
+func TestPolyhedralNet(t *testing.T) {
+	proj := NewQuadrilateralizedCube()
+	net := proj.Net()
+	if len(net) != 6 {
+		t.Fatalf("expected 6 faces, got %d", len(net))
+	}
+	// the face centered on (0, 0) is the root, and so centered on the origin
+	if x, y := proj.Project(0, 0); !withinTolerance(x, 0, 1e-12) || !withinTolerance(y, 0, 1e-12) {
+		t.Errorf("expected the root face centered on the origin, got %e,%e", x, y)
+	}
+	bounds := proj.PlanarBounds()
+	side := math.Sqrt(4 * math.Pi / 6)
+	if !withinTolerance(bounds.Width(), 4*side, 1e-9) || !withinTolerance(bounds.Height(), 3*side, 1e-9) {
+		t.Errorf("expected a %f by %f cross, got %f by %f", 4*side, 3*side, bounds.Width(), bounds.Height())
+	}
+	if bounds.Within(-1.5*side, 1.5*side*0.9) {
+		t.Errorf("expected the corners of the cross to be outside of the bounds")
+	}
+	if lat, lon := proj.Inverse(-1.5*side, 1.5*side*0.9); !math.IsNaN(lat) || !math.IsNaN(lon) {
+		t.Errorf("expected no location outside of the net, got %e,%e", lat, lon)
+	}
+}
+
+func TestPolyhedralInvalidNet(t *testing.T) {
+	for _, parents := range [][]int{{-1, 0, 1, 0, 0}, {-1, 0, 1, 0, 0, -1}, {-1, 0, 1, 0, 0, 4}, {1, 0, 1, 0, 0, 0}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected a panic for parents %v", parents)
+				}
+			}()
+			NewPolyhedralProjection(NewCube(), FaceEqualArea, parents, 0)
+		}()
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic for conformal square faces")
+		}
+	}()
+	NewPolyhedralProjection(NewCube(), FaceConformal, []int{-1, 0, 1, 0, 0, 0}, 0)
+}
+
+func TestConformalIcosahedralEdges(t *testing.T) {
+	proj := NewConformalIcosahedral()
+	// the middle of an outer edge of the net, a branch point of the face map
+	if x, y := proj.Project(0, 0.3141592653589794); !proj.PlanarBounds().Within(x, y) {
+		t.Errorf("expected the middle of the outer edge within the net, got %e,%e", x, y)
+	}
+
+	// the longitudes either side of 180 degrees through the middle of a face, along the lines from its center to
+	// a corner and to the middle of an edge
+	for _, lat := range []float64{1.4303101013140331, 0.5575493338410809} {
+		for _, lon := range []float64{math.Pi - 4e-15, -math.Pi + 4e-15} {
+			x, y := proj.Project(lat, lon)
+			if rlat, rlon := proj.Inverse(x, y); !withinTolerance(rlat, lat, 1e-12) || !withinTolerance(rlon, lon, 1e-12) {
+				t.Errorf("expected %e,%e to invert to the same side of 180 degrees, got %e,%e", lat, lon, rlat, rlon)
+			}
+		}
+	}
+}
+
+func TestConformalFacesMeet(t *testing.T) {
+	octahedral := NewPolyhedralProjection(NewOctahedron(), FaceConformal, []int{1, -1, 1, 2, 0, 1, 2, 3}, math.Pi/3)
+	for _, proj := range []PolyhedralProjection{NewLeeTetrahedral(), NewConformalIcosahedral(), octahedral} {
+		for i, face := range proj.faces {
+			for j := range face.vertices {
+				k := (j + 1) % len(face.vertices)
+				b, c := face.vertices[j], face.vertices[k]
+				nb, nc := face.net[j], face.net[k]
+				// points along the edge, including its middle, must land on the edge of the face in the net, where
+				// the neighboring face meets it
+				for s := 0.0; s <= 1; s += 1.0 / 64 {
+					point := b.Scale(1 - s).Add(c.Scale(s)).Normalize()
+					x, y := proj.projectTriangle(face.center, b, c, face.depth, face.netCenter, nb, nc, point)
+					if d := segmentDistance(nb, nc, x, y); d > 1e-13 {
+						t.Errorf("expected the edge of face %d of %v at %f to meet the net edge, got %e away", i, proj, s, d)
+					}
+				}
+			}
+		}
+	}
+}
@@ -115,6 +115,63 @@ func TestPerspectiveInverseSanity(t *testing.T) {
 	})
 }
 
+func TestTiltedPerspectiveSanity(t *testing.T) {
+	checkProject(t, "tiltedPerspective(0, 0)", NewTiltedPerspective(0, 0, 5, 0, 0, math.Pi), []projectTestCase{
+		{0, 0, 0, 0},
+		{math.Pi / 4, 0, 0, (5.0 / (6.0 - math.Sqrt(2)/2)) * (math.Sqrt(2) / 2)},
+		{0, math.Pi / 4, (5.0 / (6.0 - math.Sqrt(2)/2)) * (math.Sqrt(2) / 2), 0},
+	})
+	checkInverse(t, "invTiltedPerspective(0, 0)", NewTiltedPerspective(0, 0, 5, 0, 0, math.Pi), []inverseTestCase{
+		{0, 0, 0, 0},
+		{0, (5.0 / (6.0 - math.Sqrt(2)/2)) * (math.Sqrt(2) / 2), math.Pi / 4, 0},
+	})
+}
+
+func TestTiltedPerspectiveOpticalAxis(t *testing.T) {
+	camLat, camLon, height, tilt, azimuth := 0.4, -0.3, 0.8, 0.5, 1.1
+	proj := NewTiltedPerspective(camLat, camLon, height, tilt, azimuth, math.Pi/2)
+	// where the optical axis meets the sphere, by the angle it subtends at the center
+	d := 1 + height
+	along := d*math.Cos(tilt) - math.Sqrt(1-d*d*math.Sin(tilt)*math.Sin(tilt))
+	angle := math.Atan2(along*math.Sin(tilt), d-along*math.Cos(tilt))
+	lat, lon := Destination(camLat, camLon, angle, azimuth)
+
+	if x, y := proj.Project(lat, lon); !withinTolerance(x, 0, 1e-12) || !withinTolerance(y, 0, 1e-12) {
+		t.Errorf("expected the optical axis to meet the sphere at the center of the image, got %e,%e", x, y)
+	}
+	if rlat, rlon := proj.Inverse(0, 0); GreatCircleDistance(lat, lon, rlat, rlon) > 1e-12 {
+		t.Errorf("expected the center of the image at %f,%f, got %f,%f", lat, lon, rlat, rlon)
+	}
+	if x, y := proj.Project(Destination(camLat, camLon, angle+0.01, azimuth)); !withinTolerance(x, 0, 1e-12) || y <= 0 {
+		t.Errorf("expected the direction of the tilt to be up, got %e,%e", x, y)
+	}
+}
+
+func TestTiltedPerspectiveHidden(t *testing.T) {
+	proj := NewTiltedPerspective(0.4, -0.3, 0.8, 0.5, 1.1, math.Pi/3)
+	beyondLat, beyondLon := Destination(0.4, -0.3, 1.0, 1.1)
+	asideLat, asideLon := Destination(0.4, -0.3, 0.7, 1.1+math.Pi/2)
+	testCases := []struct {
+		name     string
+		lat, lon float64
+	}{
+		{"Antipode", -0.4, math.Pi - 0.3},
+		{"BeyondHorizon", beyondLat, beyondLon},
+		{"OutsideView", asideLat, asideLon},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if x, y := proj.Project(tc.lat, tc.lon); !math.IsNaN(x) || !math.IsNaN(y) {
+				t.Errorf("expected %f,%f to be hidden, got %f,%f", tc.lat, tc.lon, x, y)
+			}
+		})
+	}
+	if lat, lon := proj.Inverse(0, 1); !math.IsNaN(lat) || !math.IsNaN(lon) {
+		t.Errorf("expected the sky above the horizon to be invalid, got %f,%f", lat, lon)
+	}
+}
+
 /*func TestTransverseMercatorProjectSanity(t *testing.T) {
 	xFrom := func(lat, lon float64) float64 {
 		return math.Log((1+math.Sin(lon)*math.Cos(lat))/(1-math.Sin(lon)*math.Cos(lat))) / 2
@@ -22,6 +22,18 @@ func (s Sinusoidal) PlanarBounds() Bounds {
 	return NewRectangleBounds(2*math.Pi, math.Pi)
 }
 
+func (s Sinusoidal) Describe() Metadata {
+	return Metadata{
+		Name:       "Sinusoidal",
+		Aliases:    []string{"Sanson–Flamsteed", "Mercator equal-area"},
+		Family:     FamilyPseudocylindrical,
+		Properties: EqualArea | Equidistant,
+		Shape:      ShapePointedPolar,
+		Extent:     ExtentWorld,
+		PROJName:   "sinu",
+	}
+}
+
 // An equal-area pseudocylindrical map commonly used for maps of the celestial sphere.
 // https://en.wikipedia.org/wiki/Mollweide_projection
 type Mollweide struct{}
@@ -51,6 +63,18 @@ func (m Mollweide) PlanarBounds() Bounds {
 	return NewRectangleBounds(2*math.Pi, math.Pi)
 }
 
+func (m Mollweide) Describe() Metadata {
+	return Metadata{
+		Name:       "Mollweide",
+		Aliases:    []string{"Babinet", "Homalographic"},
+		Family:     FamilyPseudocylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeEllipse,
+		Extent:     ExtentWorld,
+		PROJName:   "moll",
+	}
+}
+
 // An equal-area projection combining Sinusoidal and Mollweide at different hemispheres.
 // While most presentations of this projection use an interrupted form, this type is an
 // uninterrupted version of Homolosine.
@@ -102,6 +126,126 @@ func (h Homolosine) PlanarBounds() Bounds {
 	return NewRectangleBounds(2*math.Pi, math.Pi)
 }
 
+func (h Homolosine) Describe() Metadata {
+	return Metadata{
+		Name:       "Homolosine",
+		Aliases:    []string{"Goode homolosine"},
+		Family:     FamilyPseudocylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeOther,
+		Extent:     ExtentWorld,
+		PROJName:   "goode",
+	}
+}
+
+// A pseudocylindrical projection with straight meridians broken at the equator, and polar lines half the
+// length of the equator.
+// https://en.wikipedia.org/wiki/Eckert_I_projection
+type EckertI struct{}
+
+var eckertIScale float64 = 2 * math.Sqrt(2/(3*math.Pi))
+
+func NewEckertI() EckertI {
+	return EckertI{}
+}
+
+func (e EckertI) Project(lat float64, lon float64) (float64, float64) {
+	return eckertIScale * lon * (1 - math.Abs(lat)/math.Pi), eckertIScale * lat
+}
+
+func (e EckertI) Inverse(x float64, y float64) (float64, float64) {
+	lat := y / eckertIScale
+	return lat, x / (eckertIScale * (1 - math.Abs(lat)/math.Pi))
+}
+
+func (e EckertI) PlanarBounds() Bounds {
+	return NewRectangleBounds(2*math.Pi*eckertIScale, math.Pi*eckertIScale)
+}
+
+func (e EckertI) Describe() Metadata {
+	return Metadata{
+		Name:     "Eckert I",
+		Family:   FamilyPseudocylindrical,
+		Shape:    ShapeFlatPolar,
+		Extent:   ExtentWorld,
+		PROJName: "eck1",
+	}
+}
+
+// An equal-area pseudocylindrical projection with straight meridians broken at the equator, and polar lines
+// half the length of the equator.
+// https://en.wikipedia.org/wiki/Eckert_II_projection
+type EckertII struct{}
+
+var (
+	eckertIIXScale float64 = 2 / math.Sqrt(6*math.Pi)
+	eckertIIYScale float64 = math.Sqrt(2 * math.Pi / 3)
+)
+
+func NewEckertII() EckertII {
+	return EckertII{}
+}
+
+func (e EckertII) Project(lat float64, lon float64) (float64, float64) {
+	root := math.Sqrt(4 - 3*math.Sin(math.Abs(lat)))
+	return eckertIIXScale * lon * root, math.Copysign(eckertIIYScale*(2-root), lat)
+}
+
+func (e EckertII) Inverse(x float64, y float64) (float64, float64) {
+	root := 2 - math.Abs(y)/eckertIIYScale
+	lat := math.Asin(math.Max(-1, math.Min(1, (4-root*root)/3)))
+	return math.Copysign(lat, y), x / (eckertIIXScale * root)
+}
+
+func (e EckertII) PlanarBounds() Bounds {
+	return NewRectangleBounds(4*math.Pi*eckertIIXScale, 2*eckertIIYScale)
+}
+
+func (e EckertII) Describe() Metadata {
+	return Metadata{
+		Name:       "Eckert II",
+		Family:     FamilyPseudocylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeFlatPolar,
+		Extent:     ExtentWorld,
+		PROJName:   "eck2",
+	}
+}
+
+// A pseudocylindrical projection with elliptical meridians, evenly spaced parallels and polar lines half the
+// length of the equator.
+// https://en.wikipedia.org/wiki/Eckert_III_projection
+type EckertIII struct{}
+
+var eckertIIIScale float64 = 2 / math.Sqrt(math.Pi*(4+math.Pi))
+
+func NewEckertIII() EckertIII {
+	return EckertIII{}
+}
+
+func (e EckertIII) Project(lat float64, lon float64) (float64, float64) {
+	return eckertIIIScale * lon * (1 + ellipticalMeridian(lat)), 2 * eckertIIIScale * lat
+}
+
+func (e EckertIII) Inverse(x float64, y float64) (float64, float64) {
+	lat := y / (2 * eckertIIIScale)
+	return lat, x / (eckertIIIScale * (1 + ellipticalMeridian(lat)))
+}
+
+func (e EckertIII) PlanarBounds() Bounds {
+	return NewRectangleBounds(4*math.Pi*eckertIIIScale, 2*math.Pi*eckertIIIScale)
+}
+
+func (e EckertIII) Describe() Metadata {
+	return Metadata{
+		Name:     "Eckert III",
+		Family:   FamilyPseudocylindrical,
+		Shape:    ShapeFlatPolar,
+		Extent:   ExtentWorld,
+		PROJName: "eck3",
+	}
+}
+
 // An equal-area pseudocylindrical projection, in which the polar lines are half the size of the equator.
 // https://en.wikipedia.org/wiki/Eckert_IV_projection
 type EckertIV struct{}
@@ -130,6 +274,93 @@ func (e EckertIV) PlanarBounds() Bounds {
 	return NewRectangleBounds(4, 2)
 }
 
+func (e EckertIV) Describe() Metadata {
+	return Metadata{
+		Name:       "Eckert IV",
+		Family:     FamilyPseudocylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeFlatPolar,
+		Extent:     ExtentWorld,
+		PROJName:   "eck4",
+	}
+}
+
+// A pseudocylindrical projection with sinusoidal meridians, evenly spaced parallels and polar lines half the
+// length of the equator.
+// https://en.wikipedia.org/wiki/Eckert_V_projection
+type EckertV struct{}
+
+var eckertVScale float64 = 1 / math.Sqrt(2+math.Pi)
+
+func NewEckertV() EckertV {
+	return EckertV{}
+}
+
+func (e EckertV) Project(lat float64, lon float64) (float64, float64) {
+	return eckertVScale * lon * (1 + math.Cos(lat)), 2 * eckertVScale * lat
+}
+
+func (e EckertV) Inverse(x float64, y float64) (float64, float64) {
+	lat := y / (2 * eckertVScale)
+	return lat, x / (eckertVScale * (1 + math.Cos(lat)))
+}
+
+func (e EckertV) PlanarBounds() Bounds {
+	return NewRectangleBounds(4*math.Pi*eckertVScale, 2*math.Pi*eckertVScale)
+}
+
+func (e EckertV) Describe() Metadata {
+	return Metadata{
+		Name:     "Eckert V",
+		Family:   FamilyPseudocylindrical,
+		Shape:    ShapeFlatPolar,
+		Extent:   ExtentWorld,
+		PROJName: "eck5",
+	}
+}
+
+// An equal-area pseudocylindrical projection with sinusoidal meridians and polar lines half the length of the
+// equator.
+// https://en.wikipedia.org/wiki/Eckert_VI_projection
+type EckertVI struct{}
+
+func NewEckertVI() EckertVI {
+	return EckertVI{}
+}
+
+func (e EckertVI) Project(lat float64, lon float64) (float64, float64) {
+	k := (1 + math.Pi/2) * math.Sin(lat)
+	theta := newtonsMethod(lat,
+		func(t float64) float64 { return t + math.Sin(t) - k },
+		func(t float64) float64 { return 1 + math.Cos(t) },
+		1e-12, 1e-15, 125)
+	if math.IsNaN(theta) {
+		theta = math.Copysign(math.Pi/2, lat)
+	}
+	return eckertVScale * lon * (1 + math.Cos(theta)), 2 * eckertVScale * theta
+}
+
+func (e EckertVI) Inverse(x float64, y float64) (float64, float64) {
+	theta := y / (2 * eckertVScale)
+	lat := math.Asin(math.Max(-1, math.Min(1, (theta+math.Sin(theta))/(1+math.Pi/2))))
+	return lat, x / (eckertVScale * (1 + math.Cos(theta)))
+}
+
+func (e EckertVI) PlanarBounds() Bounds {
+	return NewRectangleBounds(4*math.Pi*eckertVScale, 2*math.Pi*eckertVScale)
+}
+
+func (e EckertVI) Describe() Metadata {
+	return Metadata{
+		Name:       "Eckert VI",
+		Family:     FamilyPseudocylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeFlatPolar,
+		Extent:     ExtentWorld,
+		PROJName:   "eck6",
+	}
+}
+
 // An equal-area pseudocylindrical projection.
 // https://en.wikipedia.org/wiki/Equal_Earth_projection
 type EqualEarth struct{}
@@ -157,10 +388,509 @@ func (e EqualEarth) Project(latitude float64, longitude float64) (float64, float
 }
 
 func (e EqualEarth) Inverse(x float64, y float64) (float64, float64) {
-	theta := newtonsMethod(y/eeYscale, equalEarthPoly, equalEarthDeriv, 1e-6, 1e-15, 125)
+	theta := newtonsMethod(y/eeYscale,
+		func(t float64) float64 {
+			return equalEarthPoly(t) - y
+		},
+		equalEarthDeriv, 1e-9, 1e-15, 125)
 	return math.Asin(math.Sin(theta) / eeB), x * eeB / math.Cos(theta) * equalEarthDeriv(theta)
 }
 
 func (e EqualEarth) PlanarBounds() Bounds {
 	return NewRectangleBounds(2*math.Pi, math.Pi)
 }
+
+func (e EqualEarth) Describe() Metadata {
+	return Metadata{
+		Name:       "Equal Earth",
+		Family:     FamilyPseudocylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeFlatPolar,
+		Extent:     ExtentWorld,
+		EPSGMethod: 1078,
+		PROJName:   "eqearth",
+	}
+}
+
+// A pseudocylindrical projection in which rhumb lines (loxodromes) from the central point are straight and
+// true to scale along their length, with the central latitude its point of true shape.
+// https://en.wikipedia.org/wiki/Loximuthal_projection
+type Loximuthal struct {
+	CentralLat float64 // The latitude (in radians) of the central point, which is free of distortion.
+}
+
+// Construct a loximuthal projection centered at the given latitude in radians. Values around 40 degrees
+// are the most commonly used.
+func NewLoximuthal(centralLat float64) Loximuthal {
+	return Loximuthal{CentralLat: centralLat}
+}
+
+func (l Loximuthal) Project(lat float64, lon float64) (float64, float64) {
+	return lon * rhumbStretch(l.CentralLat, lat), lat - l.CentralLat
+}
+
+func (l Loximuthal) Inverse(x float64, y float64) (float64, float64) {
+	lat := y + l.CentralLat
+	stretch := rhumbStretch(l.CentralLat, lat)
+	if stretch == 0 {
+		// the pole is a point, on every meridian
+		return lat, 0
+	}
+	return lat, x / stretch
+}
+
+func (l Loximuthal) PlanarBounds() Bounds {
+	return RectangleBounds{
+		XMin: -math.Pi,
+		XMax: math.Pi,
+		YMin: -math.Pi/2 - l.CentralLat,
+		YMax: math.Pi/2 - l.CentralLat,
+	}
+}
+
+func (l Loximuthal) Describe() Metadata {
+	return Metadata{
+		Name:       "Loximuthal",
+		Family:     FamilyPseudocylindrical,
+		Properties: Equidistant,
+		Shape:      ShapeOther,
+		Extent:     ExtentWorld,
+		PROJName:   "loxim",
+	}
+}
+
+// The half-width of the elliptical meridian at the edge of the map at the given latitude, relative to its
+// half-width at the equator, as used by the Eckert III projection.
+func ellipticalMeridian(lat float64) float64 {
+	return math.Sqrt(math.Max(0, 1-4*lat*lat/(math.Pi*math.Pi)))
+}
+
+// An equal-area pseudocylindrical projection with sinusoidal meridians and polar lines half the length of the
+// equator, built by Wagner from the sinusoidal projection of the sphere between latitudes ±60°.
+// https://en.wikipedia.org/wiki/Kavrayskiy_VI_projection
+type WagnerI struct{}
+
+var (
+	wagnerIN      float64 = math.Sqrt(3) / 2
+	wagnerIXScale float64 = 0.8773826753
+	wagnerIYScale float64 = 1.139753528477 / wagnerIN
+)
+
+func NewWagnerI() WagnerI {
+	return WagnerI{}
+}
+
+func (w WagnerI) Project(lat float64, lon float64) (float64, float64) {
+	theta := math.Asin(wagnerIN * math.Sin(lat))
+	return wagnerIXScale * lon * math.Cos(theta), wagnerIYScale * theta
+}
+
+func (w WagnerI) Inverse(x float64, y float64) (float64, float64) {
+	theta := y / wagnerIYScale
+	return math.Asin(math.Max(-1, math.Min(1, math.Sin(theta)/wagnerIN))), x / (wagnerIXScale * math.Cos(theta))
+}
+
+func (w WagnerI) PlanarBounds() Bounds {
+	return NewRectangleBounds(2*math.Pi*wagnerIXScale, 2*math.Pi/3*wagnerIYScale)
+}
+
+func (w WagnerI) Describe() Metadata {
+	return Metadata{
+		Name:       "Wagner I",
+		Aliases:    []string{"Kavrayskiy VI"},
+		Family:     FamilyPseudocylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeFlatPolar,
+		Extent:     ExtentWorld,
+		PROJName:   "wag1",
+	}
+}
+
+// A compromise pseudocylindrical projection with sinusoidal meridians, reducing the area exaggeration of the
+// polar regions found in the Wagner I projection.
+// https://en.wikipedia.org/wiki/Wagner_projection
+type WagnerII struct{}
+
+const (
+	wagnerIIXScale float64 = 0.92483
+	wagnerIIYScale float64 = 1.38725
+	wagnerIIM1     float64 = 0.88022
+	wagnerIIM2     float64 = 0.88550
+)
+
+func NewWagnerII() WagnerII {
+	return WagnerII{}
+}
+
+func (w WagnerII) Project(lat float64, lon float64) (float64, float64) {
+	theta := math.Asin(wagnerIIM1 * math.Sin(wagnerIIM2*lat))
+	return wagnerIIXScale * lon * math.Cos(theta), wagnerIIYScale * theta
+}
+
+func (w WagnerII) Inverse(x float64, y float64) (float64, float64) {
+	theta := y / wagnerIIYScale
+	lat := math.Asin(math.Max(-1, math.Min(1, math.Sin(theta)/wagnerIIM1))) / wagnerIIM2
+	return lat, x / (wagnerIIXScale * math.Cos(theta))
+}
+
+func (w WagnerII) PlanarBounds() Bounds {
+	_, y := w.Project(math.Pi/2, 0)
+	return NewRectangleBounds(2*math.Pi*wagnerIIXScale, 2*y)
+}
+
+func (w WagnerII) Describe() Metadata {
+	return Metadata{
+		Name:     "Wagner II",
+		Family:   FamilyPseudocylindrical,
+		Shape:    ShapeFlatPolar,
+		Extent:   ExtentWorld,
+		PROJName: "wag2",
+	}
+}
+
+// A pseudocylindrical projection with sinusoidal meridians and evenly spaced parallels, true to scale along
+// the chosen pair of parallels.
+// https://en.wikipedia.org/wiki/Wagner_projection
+type WagnerIII struct {
+	TrueScaleLat float64 // The latitude (in radians) of the parallels along which the map is true to scale.
+}
+
+// Construct a Wagner III projection true to scale along the parallels at ±trueScaleLat, in radians. The
+// equator is most commonly used.
+func NewWagnerIII(trueScaleLat float64) WagnerIII {
+	return WagnerIII{TrueScaleLat: trueScaleLat}
+}
+
+func (w WagnerIII) Project(lat float64, lon float64) (float64, float64) {
+	return w.xScale() * lon * math.Cos(2*lat/3), lat
+}
+
+func (w WagnerIII) Inverse(x float64, y float64) (float64, float64) {
+	return y, x / (w.xScale() * math.Cos(2*y/3))
+}
+
+func (w WagnerIII) PlanarBounds() Bounds {
+	return NewRectangleBounds(2*math.Pi*w.xScale(), math.Pi)
+}
+
+func (w WagnerIII) Describe() Metadata {
+	return Metadata{
+		Name:     "Wagner III",
+		Family:   FamilyPseudocylindrical,
+		Shape:    ShapeFlatPolar,
+		Extent:   ExtentWorld,
+		PROJName: "wag3",
+	}
+}
+
+func (w WagnerIII) xScale() float64 {
+	return math.Cos(w.TrueScaleLat) / math.Cos(2*w.TrueScaleLat/3)
+}
+
+// An equal-area pseudocylindrical projection with elliptical meridians and polar lines half the length of the
+// equator, built by Wagner from the Mollweide projection of the sphere between latitudes ±60°.
+// https://en.wikipedia.org/wiki/Wagner_projection
+type WagnerIV struct{}
+
+var (
+	wagnerIVC      float64 = 2*math.Pi/3 + math.Sqrt(3)/2
+	wagnerIVXScale float64 = 2 * math.Sqrt(2*math.Pi*math.Sqrt(3)/2/wagnerIVC) / math.Pi
+	wagnerIVYScale float64 = math.Sqrt(2*math.Pi*math.Sqrt(3)/2/wagnerIVC) / (math.Sqrt(3) / 2)
+)
+
+func NewWagnerIV() WagnerIV {
+	return WagnerIV{}
+}
+
+func (w WagnerIV) Project(lat float64, lon float64) (float64, float64) {
+	return mollweideFamilyProject(lat, lon, wagnerIVC, wagnerIVXScale, wagnerIVYScale)
+}
+
+func (w WagnerIV) Inverse(x float64, y float64) (float64, float64) {
+	return mollweideFamilyInverse(x, y, wagnerIVC, wagnerIVXScale, wagnerIVYScale)
+}
+
+func (w WagnerIV) PlanarBounds() Bounds {
+	return NewRectangleBounds(2*math.Pi*wagnerIVXScale, 2*wagnerIVYScale)
+}
+
+func (w WagnerIV) Describe() Metadata {
+	return Metadata{
+		Name:       "Wagner IV",
+		Aliases:    []string{"Putniņš P2'"},
+		Family:     FamilyPseudocylindrical,
+		Properties: EqualArea,
+		Shape:      ShapeFlatPolar,
+		Extent:     ExtentWorld,
+		PROJName:   "wag4",
+	}
+}
+
+// A compromise pseudocylindrical projection with elliptical meridians, reducing the area exaggeration of the
+// polar regions found in the Wagner IV projection.
+// https://en.wikipedia.org/wiki/Wagner_projection
+type WagnerV struct{}
+
+const (
+	wagnerVC      float64 = 3.00896
+	wagnerVXScale float64 = 0.90977
+	wagnerVYScale float64 = 1.65014
+)
+
+func NewWagnerV() WagnerV {
+	return WagnerV{}
+}
+
+func (w WagnerV) Project(lat float64, lon float64) (float64, float64) {
+	return mollweideFamilyProject(lat, lon, wagnerVC, wagnerVXScale, wagnerVYScale)
+}
+
+func (w WagnerV) Inverse(x float64, y float64) (float64, float64) {
+	return mollweideFamilyInverse(x, y, wagnerVC, wagnerVXScale, wagnerVYScale)
+}
+
+func (w WagnerV) PlanarBounds() Bounds {
+	return NewRectangleBounds(2*math.Pi*wagnerVXScale, 2*wagnerVYScale)
+}
+
+func (w WagnerV) Describe() Metadata {
+	return Metadata{
+		Name:     "Wagner V",
+		Family:   FamilyPseudocylindrical,
+		Shape:    ShapeFlatPolar,
+		Extent:   ExtentWorld,
+		PROJName: "wag5",
+	}
+}
+
+// A compromise pseudocylindrical projection with elliptical meridians and evenly spaced parallels, with polar
+// lines half the length of the equator.
+// https://en.wikipedia.org/wiki/Wagner_VI_projection
+type WagnerVI struct{}
+
+const wagnerVIScale float64 = 0.94745
+
+func NewWagnerVI() WagnerVI {
+	return WagnerVI{}
+}
+
+func (w WagnerVI) Project(lat float64, lon float64) (float64, float64) {
+	return wagnerVIScale * lon * math.Sqrt(1-3*lat*lat/(math.Pi*math.Pi)), wagnerVIScale * lat
+}
+
+func (w WagnerVI) Inverse(x float64, y float64) (float64, float64) {
+	lat := y / wagnerVIScale
+	return lat, x / (wagnerVIScale * math.Sqrt(1-3*lat*lat/(math.Pi*math.Pi)))
+}
+
+func (w WagnerVI) PlanarBounds() Bounds {
+	return NewRectangleBounds(2*math.Pi*wagnerVIScale, math.Pi*wagnerVIScale)
+}
+
+func (w WagnerVI) Describe() Metadata {
+	return Metadata{
+		Name:     "Wagner VI",
+		Family:   FamilyPseudocylindrical,
+		Shape:    ShapeFlatPolar,
+		Extent:   ExtentWorld,
+		PROJName: "wag6",
+	}
+}
+
+// The pseudocylindrical projections in the family of Mollweide, with the auxiliary angle t solving
+// 2t + sin 2t = c sin(lat), elliptical meridians spaced by xScale cos t and parallels placed at yScale sin t.
+func mollweideFamilyProject(lat float64, lon float64, c float64, xScale float64, yScale float64) (float64, float64) {
+	k := c * math.Sin(lat)
+	theta := newtonsMethod(lat,
+		func(t float64) float64 { return t + math.Sin(t) - k },
+		func(t float64) float64 { return 1 + math.Cos(t) },
+		1e-12, 1e-15, 125)
+	if math.IsNaN(theta) {
+		theta = math.Copysign(math.Pi, lat)
+	}
+	return xScale * lon * math.Cos(theta/2), yScale * math.Sin(theta/2)
+}
+
+func mollweideFamilyInverse(x float64, y float64, c float64, xScale float64, yScale float64) (float64, float64) {
+	theta := math.Asin(math.Max(-1, math.Min(1, y/yScale)))
+	lon := x / (xScale * math.Cos(theta))
+	return math.Asin(math.Max(-1, math.Min(1, (2*theta+math.Sin(2*theta))/c))), lon
+}
+
+// An equal-area projection of the whole sphere with curved parallels and polar lines, built by Wagner from the
+// Hammer projection of the part of the sphere between latitudes ±65° and longitudes ±60°.
+// https://en.wikipedia.org/wiki/Wagner_VII_projection
+type WagnerVII struct{}
+
+var wagnerVIIForm umbeziffern = newUmbeziffern(radians(65), radians(60), 0, 2)
+
+func NewWagnerVII() WagnerVII {
+	return WagnerVII{}
+}
+
+func (w WagnerVII) Project(lat float64, lon float64) (float64, float64) {
+	return wagnerVIIForm.project(lat, lon)
+}
+
+func (w WagnerVII) Inverse(x float64, y float64) (float64, float64) {
+	return wagnerVIIForm.inverse(x, y)
+}
+
+func (w WagnerVII) PlanarBounds() Bounds {
+	return wagnerVIIForm.bounds()
+}
+
+func (w WagnerVII) Describe() Metadata {
+	return Metadata{
+		Name:       "Wagner VII",
+		Aliases:    []string{"Hammer-Wagner"},
+		Family:     FamilyLenticular,
+		Properties: EqualArea,
+		Shape:      ShapeFlatPolar,
+		Extent:     ExtentWorld,
+		PROJName:   "wag7",
+	}
+}
+
+// A compromise variant of the Wagner VII projection, spacing the parallels to inflate areas at latitude 60° by
+// a fifth in exchange for less angular distortion towards the poles.
+// https://en.wikipedia.org/wiki/Wagner_VII_projection
+type WagnerVIII struct{}
+
+var wagnerVIIIForm umbeziffern = newUmbeziffern(radians(65), radians(60), 0.2, 2)
+
+func NewWagnerVIII() WagnerVIII {
+	return WagnerVIII{}
+}
+
+func (w WagnerVIII) Project(lat float64, lon float64) (float64, float64) {
+	return wagnerVIIIForm.project(lat, lon)
+}
+
+func (w WagnerVIII) Inverse(x float64, y float64) (float64, float64) {
+	return wagnerVIIIForm.inverse(x, y)
+}
+
+func (w WagnerVIII) PlanarBounds() Bounds {
+	return wagnerVIIIForm.bounds()
+}
+
+func (w WagnerVIII) Describe() Metadata {
+	return Metadata{
+		Name:   "Wagner VIII",
+		Family: FamilyLenticular,
+		Shape:  ShapeFlatPolar,
+		Extent: ExtentWorld,
+	}
+}
+
+// A compromise projection of the whole sphere with curved parallels and polar lines, built by Wagner from the
+// Aitoff projection of the part of the sphere between latitudes ±70° and longitudes ±50°, with the equator and
+// central meridian kept at true length.
+// https://en.wikipedia.org/wiki/Wagner_projection
+type WagnerIX struct{}
+
+func NewWagnerIX() WagnerIX {
+	return WagnerIX{}
+}
+
+func (w WagnerIX) Project(lat float64, lon float64) (float64, float64) {
+	lat, lon = 7*lat/9, 5*lon/18
+	dist := math.Acos(math.Cos(lat) * math.Cos(lon))
+	stretch := 1.0
+	if dist != 0 {
+		stretch = dist / math.Sin(dist)
+	}
+	return 18.0 / 5 * stretch * math.Cos(lat) * math.Sin(lon), 9.0 / 7 * stretch * math.Sin(lat)
+}
+
+func (w WagnerIX) Inverse(x float64, y float64) (float64, float64) {
+	x, y = 5*x/18, 7*y/9
+	dist := math.Hypot(x, y)
+	shrink := 1.0
+	if dist != 0 {
+		shrink = math.Sin(dist) / dist
+	}
+	lat := math.Asin(math.Max(-1, math.Min(1, y*shrink)))
+	lon := math.Atan2(x*shrink, math.Cos(dist))
+	return 9 * lat / 7, 18 * lon / 5
+}
+
+func (w WagnerIX) PlanarBounds() Bounds {
+	x, _ := w.Project(0, math.Pi)
+	_, y := w.Project(math.Pi/2, math.Pi)
+	return NewRectangleBounds(2*x, 2*y)
+}
+
+func (w WagnerIX) Describe() Metadata {
+	return Metadata{
+		Name:    "Wagner IX",
+		Aliases: []string{"Aitoff-Wagner"},
+		Family:  FamilyLenticular,
+		Shape:   ShapeFlatPolar,
+		Extent:  ExtentWorld,
+	}
+}
+
+// Wagner's 'Umbeziffern' of the Hammer projection: the part of the sphere between latitudes ±poleLine and
+// longitudes ±meridianBound is renumbered to cover the whole sphere and projected with the Lambert azimuthal
+// projection, then stretched to the given ratio of width to height. Areas at latitude 60° are inflated by the
+// given fraction, so a zero inflation keeps the projection equal-area.
+type umbeziffern struct {
+	xScale  float64
+	yScale  float64
+	m1      float64
+	m2      float64
+	n       float64
+	ellipse bool // Whether the poles and the meridians ±Pi are renumbered onto the circle around a hemisphere.
+}
+
+func newUmbeziffern(poleLine float64, meridianBound float64, inflation float64, ratio float64) umbeziffern {
+	m2 := 1.0
+	if inflation != 0 {
+		m2 = math.Acos((1+inflation)*math.Cos(math.Pi/3)) / (math.Pi / 3)
+	}
+	m1 := math.Sin(poleLine) / math.Sin(m2*math.Pi/2)
+	n := meridianBound / math.Pi
+	k := math.Sqrt(ratio * math.Sin(poleLine/2) / math.Sin(meridianBound/2))
+	s := math.Sqrt(n * m1 * m2)
+	ellipse := poleLine == math.Pi/2 && meridianBound == math.Pi/2
+	return umbeziffern{xScale: k / s, yScale: 1 / (k * s), m1: m1, m2: m2, n: n, ellipse: ellipse}
+}
+
+func (u umbeziffern) project(lat float64, lon float64) (float64, float64) {
+	sinLat := u.m1 * math.Sin(u.m2*lat)
+	cosLat := math.Sqrt(1 - sinLat*sinLat)
+	if u.m1 == 1 && u.m2 == 1 {
+		// latitudes are not renumbered, so avoid the cancellation near the poles
+		cosLat = math.Cos(lat)
+	}
+	k := math.Sqrt(2 / (1 + cosLat*math.Cos(u.n*lon)))
+	x, y := u.xScale*k*cosLat*math.Sin(u.n*lon), u.yScale*k*sinLat
+	if u.ellipse {
+		return withinEllipse(x, y, math.Sqrt2*u.xScale, math.Sqrt2*u.yScale)
+	}
+	return x, y
+}
+
+func (u umbeziffern) inverse(x float64, y float64) (float64, float64) {
+	x, y = x/u.xScale, y/u.yScale
+	rho2 := x*x + y*y
+	if rho2 > 4 {
+		// beyond the image of the whole sphere under the Lambert azimuthal projection
+		return math.NaN(), math.NaN()
+	}
+	z := math.Sqrt(math.Max(0, 1-rho2/4))
+	lat := math.Asin(math.Max(-1, math.Min(1, y*z/u.m1))) / u.m2
+	return lat, math.Atan2(x*z, 1-rho2/2) / u.n
+}
+
+func (u umbeziffern) bounds() Bounds {
+	if u.ellipse {
+		// the hemisphere of the Lambert azimuthal projection is a circle of radius √2, stretched
+		return NewEllipseBounds(math.Sqrt2*u.xScale, math.Sqrt2*u.yScale)
+	}
+	x, _ := u.project(0, math.Pi)
+	_, y := u.project(math.Pi/2, math.Pi)
+	return NewRectangleBounds(2*x, 2*y)
+}
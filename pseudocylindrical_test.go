@@ -0,0 +1,67 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPseudocylindricalEqualArea(t *testing.T) {
+	projections := []Projection{
+		NewEckertII(), NewEckertVI(), NewWagnerI(), NewWagnerIV(), NewWagnerVII(),
+	}
+	reference := make([]float64, len(projections))
+	for i, proj := range projections {
+		reference[i] = AreaDistortionAt(proj, 0.1, 0.1)
+	}
+	for i, proj := range projections {
+		for lat := -85.0; lat < 86; lat += 8.5 {
+			for lon := -175.0; lon < 176; lon += 17.5 {
+				if area := AreaDistortionAt(proj, lat*math.Pi/180, lon*math.Pi/180); !withinTolerance(area, reference[i], 0.00001) {
+					t.Errorf("expected %T to be equal-area at %f,%f, got area distortion %e against %e", proj, lat, lon, area, reference[i])
+				}
+			}
+		}
+	}
+}
+
+func TestPseudocylindricalPolarLines(t *testing.T) {
+	projections := []Projection{
+		NewEckertI(), NewEckertII(), NewEckertIII(), NewEckertV(), NewEckertVI(), NewWagnerI(), NewWagnerIV(), NewWagnerVI(),
+	}
+	for _, proj := range projections {
+		equator, _ := proj.Project(0, math.Pi)
+		pole, _ := proj.Project(math.Pi/2, math.Pi)
+		if !withinTolerance(pole, equator/2, 1e-9) {
+			t.Errorf("expected %T to have polar lines half the length of the equator, got %f against %f", proj, pole, equator)
+		}
+	}
+}
+
+func TestPseudocylindricalLandmarks(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proj     Projection
+		lat, lon float64
+		x, y     float64
+	}{
+		{"EckertINorthPole", NewEckertI(), 90, 180, math.Sqrt(2 * math.Pi / 3), math.Sqrt(2 * math.Pi / 3)},
+		{"EckertIIEquator", NewEckertII(), 0, 180, 2 * math.Sqrt(2*math.Pi/3), 0},
+		{"EckertIIIEquator", NewEckertIII(), 0, -180, -4 * math.Pi / math.Sqrt(math.Pi*(4+math.Pi)), 0},
+		{"EckertVNorthPole", NewEckertV(), 90, 0, 0, math.Pi / math.Sqrt(2+math.Pi)},
+		{"EckertVINorthPole", NewEckertVI(), 90, 0, 0, math.Pi / math.Sqrt(2+math.Pi)},
+		{"WagnerIIIEquator", NewWagnerIII(0), 0, 90, math.Pi / 2, 0},
+		{"WagnerIIITrueScale", NewWagnerIII(math.Pi / 6), 30, 90, math.Pi / 2 * math.Cos(math.Pi/6), math.Pi / 6},
+		{"WagnerVIIEquator", NewWagnerVII(), 0, 180, 2.66723, 0},
+		{"WagnerIXEquator", NewWagnerIX(), 0, 180, math.Pi, 0},
+		{"WagnerIXNorthPole", NewWagnerIX(), 90, 0, 0, math.Pi / 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			x, y := tc.proj.Project(tc.lat*math.Pi/180, tc.lon*math.Pi/180)
+			if !withinTolerance(x, tc.x, 1e-5) || !withinTolerance(y, tc.y, 1e-5) {
+				t.Errorf("expected %f,%f, got %f,%f", tc.x, tc.y, x, y)
+			}
+		})
+	}
+}
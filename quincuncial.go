@@ -0,0 +1,310 @@
+package flatsphere
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// The quincuncial projections map a hemisphere conformally onto a square by an elliptic integral, composing
+// a stereographic projection onto the unit disk with the conformal map of the disk onto a square. The other
+// hemisphere is then laid out around it by reflection across the edges of the square, which continues the
+// map conformally everywhere except at the corners of the hemisphere's square. Each is scaled to be true at
+// its center, where the hemisphere's square has a half-diagonal of √2 K(1/2).
+
+// A conformal projection of the whole sphere into a square, with the north pole at the center, the equator
+// as a square inscribed corner to corner in the map, and the southern hemisphere split into the four corner
+// triangles, meeting at the south pole in every corner. The map tiles the plane. The prime meridian runs down
+// from the north pole, and the cuts are along the south halves of the 0, 90, 180 and 270 degree meridians.
+// https://en.wikipedia.org/wiki/Peirce_quincuncial_projection
+type PeirceQuincuncial struct{}
+
+func NewPeirceQuincuncial() PeirceQuincuncial {
+	return PeirceQuincuncial{}
+}
+
+func (p PeirceQuincuncial) Project(lat float64, lon float64) (float64, float64) {
+	x, y := polarToSquare(math.Abs(lat), lon)
+	if lat >= 0 {
+		return x, y
+	}
+	// the southern hemisphere is the northern one reflected across the edges of the equator's square
+	d := quincuncialHalfDiagonal()
+	x, y = reflectAcrossDiamond(x, y, d)
+	return math.Max(-d, math.Min(d, x)), math.Max(-d, math.Min(d, y))
+}
+
+func (p PeirceQuincuncial) Inverse(x float64, y float64) (float64, float64) {
+	d := quincuncialHalfDiagonal()
+	if math.Abs(x)+math.Abs(y) <= d {
+		return polarFromSquare(x, y)
+	}
+	// reflection keeps the quadrant, which roundoff mustn't lose for points on the cuts
+	rx, ry := reflectAcrossDiamond(x, y, d)
+	lat, lon := polarFromSquare(math.Copysign(rx, x), math.Copysign(ry, y))
+	return -lat, lon
+}
+
+func (p PeirceQuincuncial) PlanarBounds() Bounds {
+	d := quincuncialHalfDiagonal()
+	return NewRectangleBounds(2*d, 2*d)
+}
+
+func (p PeirceQuincuncial) Describe() Metadata {
+	return Metadata{
+		Name:       "Peirce quincuncial",
+		Properties: Conformal,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentWorld,
+		PROJName:   "peirce_q",
+	}
+}
+
+// The transverse aspect of the Peirce quincuncial projection, mapping the whole sphere conformally into a
+// rectangle twice as wide as it is high. The hemisphere centered on latitude and longitude zero is a square
+// in the middle, with the poles at the middle of its top and bottom edges, and the other hemisphere is split
+// along the 180th meridian into the squares on either side. The equator and central meridian are straight.
+// https://en.wikipedia.org/wiki/Guyou_hemisphere-in-a-square_projection
+type Guyou struct{}
+
+func NewGuyou() Guyou {
+	return Guyou{}
+}
+
+func (g Guyou) Project(lat float64, lon float64) (float64, float64) {
+	if math.Abs(lon) <= math.Pi/2 {
+		return transverseToSquare(lat, lon)
+	}
+	// the far hemisphere is the mirror image of the near one, reflected across the left or right edge
+	side := math.Copysign(1, lon)
+	x, y := transverseToSquare(lat, side*math.Pi-lon)
+	k := ellipticK(0.5)
+	return math.Max(-2*k, math.Min(2*k, 2*side*k-x)), y
+}
+
+func (g Guyou) Inverse(x float64, y float64) (float64, float64) {
+	k := ellipticK(0.5)
+	if math.Abs(x) <= k {
+		return transverseFromSquare(x, y)
+	}
+	side := math.Copysign(1, x)
+	lat, lon := transverseFromSquare(2*side*k-x, y)
+	return lat, side*math.Pi - lon
+}
+
+func (g Guyou) PlanarBounds() Bounds {
+	k := ellipticK(0.5)
+	return NewRectangleBounds(4*k, 2*k)
+}
+
+func (g Guyou) Describe() Metadata {
+	return Metadata{
+		Name:       "Guyou",
+		Aliases:    []string{"Guyou hemisphere-in-a-square"},
+		Properties: Conformal,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentWorld,
+		PROJName:   "guyou",
+	}
+}
+
+// A conformal projection of the hemisphere centered on latitude and longitude zero into a square standing on
+// one corner, with the poles at the top and bottom corners, and the equator and central meridian as straight
+// diagonals. Locations on the far hemisphere are projected onto their mirror images in the near one, as in
+// the orthographic projection.
+// https://en.wikipedia.org/wiki/Adams_hemisphere-in-a-square_projection
+type AdamsHemisphereInASquare struct{}
+
+func NewAdamsHemisphereInASquare() AdamsHemisphereInASquare {
+	return AdamsHemisphereInASquare{}
+}
+
+func (a AdamsHemisphereInASquare) Project(lat float64, lon float64) (float64, float64) {
+	if math.Abs(lon) > math.Pi/2 {
+		lon = math.Copysign(math.Pi, lon) - lon
+	}
+	return transverseToDiamond(lat, lon)
+}
+
+func (a AdamsHemisphereInASquare) Inverse(x float64, y float64) (float64, float64) {
+	return transverseFromDiamond(x, y)
+}
+
+func (a AdamsHemisphereInASquare) PlanarBounds() Bounds {
+	return quincuncialDiamondBounds()
+}
+
+func (a AdamsHemisphereInASquare) Describe() Metadata {
+	return Metadata{
+		Name:       "Adams hemisphere-in-a-square",
+		Properties: Conformal,
+		Shape:      ShapeOther,
+		Extent:     ExtentHemisphere,
+		PROJName:   "adams_hemi",
+	}
+}
+
+// A conformal projection of the whole sphere into a square, with the poles at the middle of the top and bottom
+// edges and the 180th meridian along the rest of the outline. The sphere is first mapped conformally onto a
+// hemisphere by halving its isometric latitude and longitude, as in the Lagrange projection, and the
+// hemisphere then mapped as in the Guyou projection.
+// https://en.wikipedia.org/wiki/Adams_world_in_a_square_I_projection
+type AdamsWorldInASquareI struct{}
+
+func NewAdamsWorldInASquareI() AdamsWorldInASquareI {
+	return AdamsWorldInASquareI{}
+}
+
+func (a AdamsWorldInASquareI) Project(lat float64, lon float64) (float64, float64) {
+	return transverseToSquare(halveIsometric(lat, lon))
+}
+
+func (a AdamsWorldInASquareI) Inverse(x float64, y float64) (float64, float64) {
+	return doubleIsometric(transverseFromSquare(x, y))
+}
+
+func (a AdamsWorldInASquareI) PlanarBounds() Bounds {
+	k := ellipticK(0.5)
+	return NewRectangleBounds(2*k, 2*k)
+}
+
+func (a AdamsWorldInASquareI) Describe() Metadata {
+	return Metadata{
+		Name:       "Adams world in a square I",
+		Properties: Conformal,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentWorld,
+		PROJName:   "adams_ws1",
+	}
+}
+
+// A conformal projection of the whole sphere into a square standing on one corner, with the poles at the top
+// and bottom corners and the 180th meridian along the outline. The sphere is first mapped conformally onto a
+// hemisphere by halving its isometric latitude and longitude, as in the Lagrange projection, and the
+// hemisphere then mapped as in the Adams hemisphere-in-a-square projection.
+// https://en.wikipedia.org/wiki/Adams_world_in_a_square_II_projection
+type AdamsWorldInASquareII struct{}
+
+func NewAdamsWorldInASquareII() AdamsWorldInASquareII {
+	return AdamsWorldInASquareII{}
+}
+
+func (a AdamsWorldInASquareII) Project(lat float64, lon float64) (float64, float64) {
+	return transverseToDiamond(halveIsometric(lat, lon))
+}
+
+func (a AdamsWorldInASquareII) Inverse(x float64, y float64) (float64, float64) {
+	return doubleIsometric(transverseFromDiamond(x, y))
+}
+
+func (a AdamsWorldInASquareII) PlanarBounds() Bounds {
+	return quincuncialDiamondBounds()
+}
+
+func (a AdamsWorldInASquareII) Describe() Metadata {
+	return Metadata{
+		Name:       "Adams world in a square II",
+		Properties: Conformal,
+		Shape:      ShapeOther,
+		Extent:     ExtentWorld,
+		PROJName:   "adams_ws2",
+	}
+}
+
+// The distance from the center of a hemisphere's square to its corners.
+func quincuncialHalfDiagonal() float64 {
+	return math.Sqrt2 * ellipticK(0.5)
+}
+
+// The outline of a hemisphere's square standing on one corner.
+func quincuncialDiamondBounds() Bounds {
+	d := quincuncialHalfDiagonal()
+	return NewPolygonBounds([][2]float64{{0, -d}, {d, 0}, {0, d}, {-d, 0}})
+}
+
+// Reflect a point across the edge of the square standing on one corner with the given half-diagonal, choosing
+// the edge in the same quadrant as the point.
+func reflectAcrossDiamond(x float64, y float64, halfDiagonal float64) (float64, float64) {
+	sx, sy := 1.0, 1.0
+	if math.Signbit(x) {
+		sx = -1
+	}
+	if math.Signbit(y) {
+		sy = -1
+	}
+	excess := sx*x + sy*y - halfDiagonal
+	return x - excess*sx, y - excess*sy
+}
+
+// Move a point lying just outside the square standing on one corner with the given half-diagonal back onto
+// its edge. Near its corners the map onto the square is only accurate to the square root of the floating-point
+// precision, since it folds straight angles into right angles, which can push the edges out by a hair.
+func clampToDiamond(x float64, y float64, halfDiagonal float64) (float64, float64) {
+	if math.Abs(x)+math.Abs(y) <= halfDiagonal {
+		return x, y
+	}
+	ex, ey := reflectAcrossDiamond(x, y, halfDiagonal)
+	return (x + ex) / 2, (y + ey) / 2
+}
+
+// Map a location in the northern hemisphere into the square standing on one corner, through the north polar
+// stereographic projection.
+func polarToSquare(lat float64, lon float64) (float64, float64) {
+	r := math.Tan(math.Pi/4 - lat/2)
+	z := 2 * diskToSquare(complex(r*math.Sin(lon), -r*math.Cos(lon)))
+	return clampToDiamond(real(z), imag(z), quincuncialHalfDiagonal())
+}
+
+func polarFromSquare(x float64, y float64) (float64, float64) {
+	w := squareToDisk(complex(x/2, y/2))
+	// the map keeps each quadrant, so keep the signs of zeros, which decide the side of the cuts at 180 degrees
+	w = complex(math.Copysign(real(w), x), math.Copysign(imag(w), y))
+	return math.Pi/2 - 2*math.Atan(cmplx.Abs(w)), math.Atan2(real(w), -imag(w))
+}
+
+// Map a location in the hemisphere centered on latitude and longitude zero into the square standing on one
+// corner, with the poles at the top and bottom corners, through the equatorial stereographic projection.
+func transverseToDiamond(lat float64, lon float64) (float64, float64) {
+	z := 2 * diskToSquare(equatorialStereographic(lat, lon))
+	return clampToDiamond(real(z), imag(z), quincuncialHalfDiagonal())
+}
+
+func transverseFromDiamond(x float64, y float64) (float64, float64) {
+	return equatorialStereographicInverse(squareToDisk(complex(x/2, y/2)))
+}
+
+// Map a location in the hemisphere centered on latitude and longitude zero into the upright square, with the
+// poles at the middle of the top and bottom edges.
+func transverseToSquare(lat float64, lon float64) (float64, float64) {
+	turn := cmplx.Rect(1, math.Pi/4)
+	z := 2 * turn * diskToSquare(equatorialStereographic(lat, lon)/turn)
+	// keep the edges within the square, as in clampToDiamond
+	k := ellipticK(0.5)
+	return math.Max(-k, math.Min(k, real(z))), math.Max(-k, math.Min(k, imag(z)))
+}
+
+func transverseFromSquare(x float64, y float64) (float64, float64) {
+	turn := cmplx.Rect(1, math.Pi/4)
+	return equatorialStereographicInverse(turn * squareToDisk(complex(x/2, y/2)/turn))
+}
+
+// The stereographic projection onto the unit disk of the hemisphere centered on latitude and longitude zero,
+// with east along the real axis and north along the imaginary axis.
+func equatorialStereographic(lat float64, lon float64) complex128 {
+	x, y, z := sphericalToCartesian(lat, lon)
+	return complex(y, z) / complex(1+x, 0)
+}
+
+func equatorialStereographicInverse(w complex128) (float64, float64) {
+	r2 := real(w)*real(w) + imag(w)*imag(w)
+	return cartesianToSpherical((1-r2)/(1+r2), 2*real(w)/(1+r2), 2*imag(w)/(1+r2))
+}
+
+// Map the whole sphere conformally onto the hemisphere centered on latitude and longitude zero by halving the
+// isometric latitude and the longitude, since tanh(ψ/2) = tan(φ/2).
+func halveIsometric(lat float64, lon float64) (float64, float64) {
+	return math.Asin(math.Tan(lat / 2)), lon / 2
+}
+
+func doubleIsometric(lat float64, lon float64) (float64, float64) {
+	return 2 * math.Atan(math.Sin(lat)), 2 * lon
+}
@@ -0,0 +1,90 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuincuncialLandmarks(t *testing.T) {
+	k := ellipticK(0.5)
+	d := math.Sqrt2 * k
+	testCases := []struct {
+		name     string
+		proj     Projection
+		lat, lon float64
+		x, y     float64
+	}{
+		{"PeirceNorthPole", NewPeirceQuincuncial(), 90, 0, 0, 0},
+		{"PeirceSouthPole", NewPeirceQuincuncial(), -90, 45, d, d},
+		{"PeirceEquatorPrime", NewPeirceQuincuncial(), 0, 0, 0, -d},
+		{"PeirceEquatorEast", NewPeirceQuincuncial(), 0, 90, d, 0},
+		{"PeirceEquatorAntimeridian", NewPeirceQuincuncial(), 0, 180, 0, d},
+		{"GuyouCenter", NewGuyou(), 0, 0, 0, 0},
+		{"GuyouNorthPole", NewGuyou(), 90, 0, 0, k},
+		{"GuyouCorner", NewGuyou(), 45, 90, k, k},
+		{"GuyouAntimeridian", NewGuyou(), 0, 180, 2 * k, 0},
+		{"GuyouWestAntimeridian", NewGuyou(), 0, -180, -2 * k, 0},
+		{"AdamsHemisphereNorthPole", NewAdamsHemisphereInASquare(), 90, 0, 0, d},
+		{"AdamsHemisphereEast", NewAdamsHemisphereInASquare(), 0, 90, d, 0},
+		{"AdamsWorldINorthPole", NewAdamsWorldInASquareI(), 90, 0, 0, k},
+		{"AdamsWorldIAntimeridian", NewAdamsWorldInASquareI(), 0, 180, k, 0},
+		{"AdamsWorldIISouthPole", NewAdamsWorldInASquareII(), -90, 0, 0, -d},
+		{"AdamsWorldIIAntimeridian", NewAdamsWorldInASquareII(), 0, -180, -d, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			x, y := tc.proj.Project(tc.lat*math.Pi/180, tc.lon*math.Pi/180)
+			// the map onto the square is only accurate to about 1e-8 at the corners of the hemisphere's square
+			if !withinTolerance(x, tc.x, 1e-7) || !withinTolerance(y, tc.y, 1e-7) {
+				t.Errorf("expected %f,%f, got %f,%f", tc.x, tc.y, x, y)
+			}
+		})
+	}
+}
+
+func TestQuincuncialConformal(t *testing.T) {
+	projections := []Projection{NewPeirceQuincuncial(), NewGuyou(), NewAdamsHemisphereInASquare(), NewAdamsWorldInASquareI(), NewAdamsWorldInASquareII()}
+	for _, proj := range projections {
+		for lat := -87.5; lat < 88; lat += 8.3 {
+			for lon := -177.5; lon < 178; lon += 9.7 {
+				if _, ok := proj.(AdamsHemisphereInASquare); ok && math.Abs(lon) > 90 {
+					continue
+				}
+				if angular := AngularDistortionAt(proj, lat*math.Pi/180, lon*math.Pi/180); !withinTolerance(angular, 0, 0.00001) {
+					t.Errorf("expected no angular distortion for %T at %f,%f, got %e", proj, lat, lon, angular)
+				}
+			}
+		}
+	}
+}
+
+func TestQuincuncialProjectInverse(t *testing.T) {
+	projections := []Projection{NewPeirceQuincuncial(), NewGuyou(), NewAdamsHemisphereInASquare(), NewAdamsWorldInASquareI(), NewAdamsWorldInASquareII()}
+	for _, proj := range projections {
+		for lat := -89.5; lat < 90; lat += 4.9 {
+			for lon := -180.0; lon <= 180; lon += 6.1 {
+				if _, ok := proj.(AdamsHemisphereInASquare); ok && math.Abs(lon) > 90 {
+					continue
+				}
+				x, y := proj.Project(lat*math.Pi/180, lon*math.Pi/180)
+				if !proj.PlanarBounds().Within(x, y) {
+					t.Errorf("expected %T to project %f,%f within its bounds, got %f,%f", proj, lat, lon, x, y)
+				}
+				rlat, rlon := proj.Inverse(x, y)
+				if distance := GreatCircleDistance(lat*math.Pi/180, lon*math.Pi/180, rlat, rlon); distance > 1e-12 {
+					t.Errorf("expected %T to invert %f,%f, got %f,%f", proj, lat, lon, rlat*180/math.Pi, rlon*180/math.Pi)
+				}
+			}
+		}
+	}
+}
+
+func TestAdamsHemisphereMirrorsFarSide(t *testing.T) {
+	proj := NewAdamsHemisphereInASquare()
+	x1, y1 := proj.Project(0.4, 2.5)
+	x2, y2 := proj.Project(0.4, math.Pi-2.5)
+	if !withinTolerance(x1, x2, 1e-15) || !withinTolerance(y1, y2, 1e-15) {
+		t.Errorf("expected the far side to project onto its mirror image %f,%f, got %f,%f", x2, y2, x1, y1)
+	}
+}
@@ -0,0 +1,122 @@
+package flatsphere
+
+import (
+	"math"
+)
+
+// A region of interest on the sphere, bounded by two parallels and two meridians, in radians. The region
+// extends eastward from West to East, so a West greater than East describes a region crossing the antimeridian.
+type Region struct {
+	South float64
+	North float64
+	West  float64
+	East  float64
+}
+
+func NewRegion(south float64, north float64, west float64, east float64) Region {
+	return Region{South: south, North: north, West: west, East: east}
+}
+
+// The difference in longitude (in radians) from the western to the eastern edge of the region, in the range [0, 2Pi].
+func (r Region) LonSpan() float64 {
+	span := r.East - r.West
+	if span < 0 {
+		span += 2 * math.Pi
+	}
+	return math.Min(span, 2*math.Pi)
+}
+
+// The difference in latitude (in radians) from the southern to the northern edge of the region.
+func (r Region) LatSpan() float64 {
+	return r.North - r.South
+}
+
+// The location (in radians) halfway between the edges of the region in latitude and in longitude.
+func (r Region) Center() (lat float64, lon float64) {
+	return (r.South + r.North) / 2, coerceAngle(r.West + r.LonSpan()/2)
+}
+
+// Choose and configure a projection suited to mapping the given region, in the manner of the Projection
+// Wizard (Šavrič et al., 2016). The property selects between Conformal projections, which preserve
+// shape, and EqualArea projections, which preserve area; any other properties are treated as EqualArea.
+//
+// Regions spanning more than half of the longitudes get a world projection shifted to the center of the
+// region. Hemispheres, small squarish regions and polar regions get an azimuthal projection centered on the
+// region, or on the pole for polar regions surrounding it. Regions that are long east to west get a cylindrical projection near the equator, and a conic
+// projection with standard parallels one sixth of the way in from the edges of the region elsewhere.
+// Regions that are long north to south get a transverse cylindrical projection along the central meridian,
+// which is rotated a quarter turn so that the central meridian is horizontal in the projection plane.
+func Recommend(region Region, property Properties) Projection {
+	conformal := property&Conformal != 0 && property&EqualArea == 0
+	centerLat, centerLon := region.Center()
+	lonSpan := region.LonSpan()
+	latSpan := region.LatSpan()
+
+	var azimuthal Projection = NewLambertAzimuthal()
+	var cylindrical Projection = NewCylindricalEqualArea(0)
+	if conformal {
+		azimuthal = NewStereographic()
+		cylindrical = NewMercator()
+	}
+
+	// polar regions, centered on the pole itself when they surround it
+	if latSpan <= math.Pi/2 && math.Abs(centerLat) > 70*math.Pi/180 {
+		if lonSpan > math.Pi {
+			return centeredAzimuthal(azimuthal, math.Copysign(math.Pi/2, centerLat), centerLon)
+		}
+		return centeredAzimuthal(azimuthal, centerLat, centerLon)
+	}
+
+	// the whole world, or at least a band around half of it
+	if lonSpan > math.Pi {
+		if conformal {
+			return NewMeridianShift(NewMercator(), centerLon)
+		}
+		return NewMeridianShift(NewEqualEarth(), centerLon)
+	}
+
+	// hemispheres
+	if lonSpan > math.Pi/2 || latSpan > math.Pi/2 {
+		return centeredAzimuthal(azimuthal, centerLat, centerLon)
+	}
+
+	// compare the extents of the region in true distance, rather than in degrees
+	ratio := latSpan / (lonSpan * math.Cos(centerLat))
+	switch {
+	case ratio > 1.25:
+		return transverseCylindrical(cylindrical, centerLat, centerLon)
+	case ratio >= 0.8:
+		return centeredAzimuthal(azimuthal, centerLat, centerLon)
+	case math.Abs(centerLat) < 15*math.Pi/180:
+		// east-west regions close to the equator are best served by a cylinder touching the center of the region
+		if conformal {
+			return NewMeridianShift(NewMercatorTrueScale(centerLat), centerLon)
+		}
+		return NewMeridianShift(NewCylindricalEqualArea(centerLat), centerLon)
+	default:
+		parallel1 := region.South + latSpan/6
+		parallel2 := region.North - latSpan/6
+		if conformal {
+			return NewMeridianShift(NewLambertConformalConic(parallel1, parallel2), centerLon)
+		}
+		return NewMeridianShift(NewAlbers(parallel1, parallel2), centerLon)
+	}
+}
+
+// Center a north polar azimuthal projection at the given location, with north upward at the center.
+func centeredAzimuthal(azimuthal Projection, lat float64, lon float64) Projection {
+	if lat == math.Pi/2 {
+		return NewMeridianShift(azimuthal, lon)
+	}
+	// rotate about the new center so that the north pole lies at the top of the polar projection
+	_, northLon := NewObliqueAspect(lat, lon, 0).TransformFromOblique(math.Pi/2, 0)
+	return NewObliqueProjection(azimuthal, lat, lon, northLon-math.Pi)
+}
+
+// Rotate a normal cylindrical projection so that its equator runs along the meridian of the given location,
+// with the location at the origin of the projection plane.
+func transverseCylindrical(cylindrical Projection, lat float64, lon float64) Projection {
+	poleLon := coerceAngle(lon + math.Pi/2)
+	_, centerLon := NewObliqueAspect(0, poleLon, 0).TransformFromOblique(lat, lon)
+	return NewObliqueProjection(cylindrical, 0, poleLon, centerLon)
+}
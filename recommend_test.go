@@ -0,0 +1,79 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRecommend(t *testing.T) {
+	deg := math.Pi / 180
+	testCases := []struct {
+		name     string
+		region   Region
+		property Properties
+		family   Family
+		aspect   Aspect
+	}{
+		{"WorldEqualArea", NewRegion(-90*deg, 90*deg, -180*deg, 180*deg), EqualArea, FamilyPseudocylindrical, AspectNormal},
+		{"WorldConformal", NewRegion(-80*deg, 80*deg, -180*deg, 180*deg), Conformal, FamilyCylindrical, AspectNormal},
+		{"PacificBand", NewRegion(-30*deg, 30*deg, 100*deg, -60*deg), EqualArea, FamilyPseudocylindrical, AspectNormal},
+		{"Antarctica", NewRegion(-90*deg, -60*deg, -180*deg, 180*deg), EqualArea, FamilyAzimuthal, AspectNormal},
+		{"Arctic", NewRegion(65*deg, 90*deg, -60*deg, 60*deg), Conformal, FamilyAzimuthal, AspectOblique},
+		{"Africa", NewRegion(-35*deg, 38*deg, -18*deg, 52*deg), EqualArea, FamilyAzimuthal, AspectOblique},
+		{"ContiguousUS", NewRegion(24*deg, 50*deg, -125*deg, -66*deg), EqualArea, FamilyConic, AspectNormal},
+		{"ContiguousUSConformal", NewRegion(24*deg, 50*deg, -125*deg, -66*deg), Conformal, FamilyConic, AspectNormal},
+		{"Chile", NewRegion(-56*deg, -17*deg, -76*deg, -66*deg), EqualArea, FamilyCylindrical, AspectTransverse},
+		{"ChileConformal", NewRegion(-56*deg, -17*deg, -76*deg, -66*deg), Conformal, FamilyCylindrical, AspectTransverse},
+		{"Indonesia", NewRegion(-11*deg, 6*deg, 95*deg, 141*deg), Conformal, FamilyCylindrical, AspectNormal},
+		{"Switzerland", NewRegion(45.8*deg, 47.8*deg, 5.9*deg, 10.5*deg), EqualArea, FamilyConic, AspectNormal},
+		{"Texas", NewRegion(26*deg, 36*deg, -106*deg, -94*deg), Conformal, FamilyAzimuthal, AspectOblique},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proj := Recommend(tc.region, tc.property)
+			meta := proj.(Describer).Describe()
+			if meta.Family != tc.family || meta.Aspect != tc.aspect {
+				t.Errorf("expected %v %v projection, got %v %v (%s)", tc.aspect, tc.family, meta.Aspect, meta.Family, meta.Name)
+			}
+			if tc.property == Conformal && !meta.Has(Conformal) {
+				t.Errorf("expected a conformal projection, got %s", meta.Name)
+			}
+			if tc.property == EqualArea && !meta.Has(EqualArea) {
+				t.Errorf("expected an equal-area projection, got %s", meta.Name)
+			}
+
+			// the center of the region should be near the middle of the map, and invert back to itself
+			lat, lon := tc.region.Center()
+			x, y := proj.Project(lat, lon)
+			rlat, rlon := proj.Inverse(x, y)
+			if !withinTolerance(rlat, lat, 0.000001) || !withinTolerance(math.Cos(rlon-lon), 1, 0.000001) {
+				t.Errorf("expected center %e,%e to invert to itself, got %e,%e", lat, lon, rlat, rlon)
+			}
+			if meta.Aspect != AspectNormal && (!withinTolerance(x, 0, 0.000001) || !withinTolerance(y, 0, 0.000001)) {
+				t.Errorf("expected center %e,%e to project to the origin, got %e,%e", lat, lon, x, y)
+			}
+		})
+	}
+}
+
+func TestRecommendAzimuthalNorthUp(t *testing.T) {
+	region := NewRegion(-35*math.Pi/180, 38*math.Pi/180, -18*math.Pi/180, 52*math.Pi/180)
+	proj := Recommend(region, EqualArea)
+	lat, lon := region.Center()
+	x, y := proj.Project(lat+0.1, lon)
+	if !withinTolerance(x, 0, 0.000001) || y <= 0 {
+		t.Errorf("expected north of the center to be straight up, got %e,%e", x, y)
+	}
+}
+
+func TestRegionSpans(t *testing.T) {
+	region := NewRegion(-0.5, 0.25, 3, -3)
+	if !withinTolerance(region.LonSpan(), 2*math.Pi-6, 0.000001) {
+		t.Errorf("expected antimeridian span %e, got %e", 2*math.Pi-6, region.LonSpan())
+	}
+	lat, lon := region.Center()
+	if !withinTolerance(lat, -0.125, 0.000001) || !withinTolerance(math.Abs(lon), math.Pi, 0.000001) {
+		t.Errorf("expected center -0.125,Pi, got %e,%e", lat, lon)
+	}
+}
@@ -0,0 +1,91 @@
+package flatsphere
+
+import "math"
+
+// Compute the length of the rhumb line (loxodrome) between two locations on the unit sphere, given in
+// radians. A rhumb line crosses every meridian at the same angle, and so appears as a straight line under
+// the Mercator projection. The result is in multiples of the sphere radius, and follows the shorter
+// direction around the sphere, crossing the antimeridian if necessary.
+func RhumbDistance(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
+	dLat := lat2 - lat1
+	dLon := coerceAngle(lon2 - lon1)
+	q := rhumbStretch(lat1, lat2)
+	return math.Hypot(dLat, q*dLon)
+}
+
+// The constant azimuth (in radians, clockwise from north) of the rhumb line from the first location
+// to the second. The result is in the range (-Pi, Pi].
+func RhumbAzimuth(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
+	dLon := coerceAngle(lon2 - lon1)
+	return math.Atan2(dLon, mercatorY(lat2)-mercatorY(lat1))
+}
+
+// Find the location reached by travelling the given distance (in multiples of the sphere radius) along
+// the rhumb line leaving the starting location at the given constant azimuth (clockwise from north).
+// A rhumb line spirals into a pole rather than passing over it, so paths that would reach a pole stop there,
+// at the starting longitude, as every meridian meets at the pole.
+func RhumbDestination(lat float64, lon float64, distance float64, azimuth float64) (float64, float64) {
+	dLat := distance * math.Cos(azimuth)
+	destLat := lat + dLat
+	if math.Abs(destLat) >= math.Pi/2 {
+		return math.Copysign(math.Pi/2, destLat), lon
+	}
+	q := rhumbStretch(lat, destLat)
+	dLon := distance * math.Sin(azimuth) / q
+	return destLat, coerceAngle(lon + dLon)
+}
+
+// Generate count+1 evenly spaced locations along the rhumb line from the first location to the second,
+// including both endpoints. Results are returned as a slice of latitude/longitude pairs. A count below 1 is
+// treated as 1, giving just the endpoints. The longitudes after the first, including that of the second
+// location, are deliberately not wrapped into [-Pi, Pi], but continue from the first by the shorter difference
+// in longitude, so that the path projects to a straight segment under NewMercator() even when it crosses the
+// antimeridian. A path to or from a pole runs along the meridian of the other location.
+func RhumbPath(lat1 float64, lon1 float64, lat2 float64, lon2 float64, count int) [][2]float64 {
+	if count < 1 {
+		count = 1
+	}
+	dLon := coerceAngle(lon2 - lon1)
+	// every meridian meets at a pole, where the isometric latitude is infinite
+	if math.Abs(lat2) == math.Pi/2 {
+		dLon = 0
+	} else if math.Abs(lat1) == math.Pi/2 {
+		lon1, dLon = lon2, 0
+	}
+	psi1 := mercatorY(lat1)
+	psi2 := mercatorY(lat2)
+
+	path := make([][2]float64, count+1)
+	path[0] = [2]float64{lat1, lon1}
+	for i := 1; i < count; i++ {
+		frac := float64(i) / float64(count)
+		if lat1 == lat2 || dLon == 0 {
+			path[i] = [2]float64{lat1 + frac*(lat2-lat1), lon1 + frac*dLon}
+			continue
+		}
+		// equal steps along a rhumb line are equal steps in latitude, placed via the mercator ordinate
+		lat := lat1 + frac*(lat2-lat1)
+		lon := lon1 + dLon*(mercatorY(lat)-psi1)/(psi2-psi1)
+		path[i] = [2]float64{lat, lon}
+	}
+	path[count] = [2]float64{lat2, lon1 + dLon}
+	return path
+}
+
+// The ratio of latitude difference to isometric latitude difference between two latitudes, which scales
+// longitude differences into distances along a rhumb line. Reduces to cos(lat) for an east-west line.
+func rhumbStretch(lat1 float64, lat2 float64) float64 {
+	dPsi := mercatorY(lat2) - mercatorY(lat1)
+	if math.Abs(dPsi) > 1e-12 && !math.IsInf(dPsi, 0) {
+		return (lat2 - lat1) / dPsi
+	}
+	if math.IsInf(dPsi, 0) {
+		return 0
+	}
+	return math.Cos(lat1)
+}
+
+// The isometric latitude, equal to the y coordinate of the Mercator projection.
+func mercatorY(lat float64) float64 {
+	return math.Log(math.Tan(math.Pi/4 + lat/2))
+}
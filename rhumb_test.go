@@ -0,0 +1,118 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRhumbDistanceAzimuth(t *testing.T) {
+	testCases := []struct {
+		name    string
+		lat1    float64
+		lon1    float64
+		lat2    float64
+		lon2    float64
+		dist    float64
+		azimuth float64
+	}{
+		{"Meridian", 0, 0, math.Pi / 4, 0, math.Pi / 4, 0},
+		{"Equator", 0, 0, 0, math.Pi / 2, math.Pi / 2, math.Pi / 2},
+		{"Parallel", math.Pi / 3, 0, math.Pi / 3, -1, 0.5, -math.Pi / 2},
+		{"AcrossAntimeridian", 0, math.Pi - 0.1, 0, -math.Pi + 0.1, 0.2, math.Pi / 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dist := RhumbDistance(tc.lat1, tc.lon1, tc.lat2, tc.lon2)
+			if !withinTolerance(dist, tc.dist, 0.000001) {
+				t.Errorf("expected distance %e, got %e", tc.dist, dist)
+			}
+			az := RhumbAzimuth(tc.lat1, tc.lon1, tc.lat2, tc.lon2)
+			if !withinTolerance(az, tc.azimuth, 0.000001) {
+				t.Errorf("expected azimuth %e, got %e", tc.azimuth, az)
+			}
+		})
+	}
+}
+
+func FuzzRhumbDestinationRoundTrip(f *testing.F) {
+	f.Add(0.0, 0.0, 1.0, 0.5)
+	f.Add(math.Pi/4, -math.Pi/2, 0.25, -2.0)
+	f.Add(-1.0, 3.0, 0.4, math.Pi/2)
+	f.Fuzz(func(t *testing.T, lat float64, lon float64, dist float64, az float64) {
+		lat = math.Mod(lat, 1.2)
+		lon = math.Mod(lon, math.Pi)
+		dist = math.Abs(math.Mod(dist, 0.3))
+		az = math.Mod(az, math.Pi)
+		if math.IsNaN(lat + lon + dist + az) {
+			t.Skip()
+		}
+		dLat, dLon := RhumbDestination(lat, lon, dist, az)
+		if got := RhumbDistance(lat, lon, dLat, dLon); !withinTolerance(got, dist, 0.000001) {
+			t.Errorf("expected rhumb distance %e, got %e", dist, got)
+		}
+	})
+}
+
+func TestRhumbDestinationStopsAtPole(t *testing.T) {
+	for _, az := range []float64{0, 0.3, -2.9} {
+		lat, lon := RhumbDestination(math.Copysign(1.2, math.Cos(az)), 0.5, 1, az)
+		if lat != math.Copysign(math.Pi/2, math.Cos(az)) || lon != 0.5 {
+			t.Errorf("expected a path at azimuth %f past the pole to stop at it, got %f,%f", az, lat, lon)
+		}
+	}
+}
+
+func TestRhumbPathStraightUnderMercator(t *testing.T) {
+	mercator := NewMercator()
+	path := RhumbPath(-0.6, 2.8, 1.1, -2.5, 16)
+	x0, y0 := mercator.Project(path[0][0], path[0][1])
+	x1, y1 := mercator.Project(path[len(path)-1][0], path[len(path)-1][1])
+	for _, p := range path {
+		x, y := mercator.Project(p[0], p[1])
+		// cross product of the segment direction with the offset to the point is zero when collinear
+		if cross := (x1-x0)*(y-y0) - (y1-y0)*(x-x0); !withinTolerance(cross, 0, 0.000001) {
+			t.Errorf("path point %e,%e projected off the straight segment by %e", p[0], p[1], cross)
+		}
+	}
+}
+
+func TestRhumbPathEdgeCases(t *testing.T) {
+	for _, count := range []int{0, -1, -3} {
+		if path := RhumbPath(0.1, 0.2, 0.5, 0.6, count); len(path) != 2 || path[0] != [2]float64{0.1, 0.2} || path[1][0] != 0.5 || !withinTolerance(path[1][1], 0.6, 1e-15) {
+			t.Errorf("expected a count of %d to give just the endpoints, got %v", count, path)
+		}
+	}
+	for _, tc := range []struct{ lat1, lon1, lat2, lon2 float64 }{
+		{-math.Pi / 2, 0.3, -math.Pi / 2, 1.2},
+		{math.Pi / 2, 0.3, 0.4, -2.0},
+		{-0.4, 2.0, -math.Pi / 2, -1.0},
+	} {
+		for _, p := range RhumbPath(tc.lat1, tc.lon1, tc.lat2, tc.lon2, 4) {
+			if math.IsNaN(p[0]) || math.IsNaN(p[1]) || math.Abs(p[1]) > math.Pi {
+				t.Errorf("expected the path from %f,%f to %f,%f to run along a meridian, got %v", tc.lat1, tc.lon1, tc.lat2, tc.lon2, p)
+			}
+		}
+	}
+}
+
+func TestLoximuthalRhumbDistance(t *testing.T) {
+	// distances from the central point along rhumb lines are true to scale
+	proj := NewLoximuthal(40 * math.Pi / 180)
+	lat, lon := 0.1, 1.3
+	x, y := proj.Project(lat, lon)
+	expected := RhumbDistance(proj.CentralLat, 0, lat, lon)
+	if !withinTolerance(math.Hypot(x, y), expected, 0.000001) {
+		t.Errorf("expected planar distance %e, got %e", expected, math.Hypot(x, y))
+	}
+}
+
+func TestLoximuthalInverseSouthPole(t *testing.T) {
+	// the rhumb lines to the south pole have no length in longitude, so it is a point
+	proj := NewLoximuthal(40 * math.Pi / 180)
+	x, y := proj.Project(-math.Pi/2, 1.3)
+	lat, lon := proj.Inverse(x, y)
+	if lat != -math.Pi/2 || math.IsNaN(lon) || math.IsInf(lon, 0) {
+		t.Errorf("expected the south pole, got %e,%e from %e,%e", lat, lon, x, y)
+	}
+}
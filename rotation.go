@@ -0,0 +1,214 @@
+package flatsphere
+
+import "math"
+
+// A rotation of the sphere about its center, stored as a 3x3 orthonormal matrix acting on unit vectors, where
+// the x axis points to latitude/longitude (0, 0), the y axis to (0, Pi/2), and the z axis to the north pole.
+// Rotating locations this way avoids the special cases and precision loss of spherical trigonometry.
+type Rotation struct {
+	m [3][3]float64
+}
+
+// The rotation which leaves every location in place.
+func NewIdentityRotation() Rotation {
+	return Rotation{[3][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}}
+}
+
+// The rotation which moves the given pole location (in radians) to the north pole, then turns the result by
+// the given angle about the north pole, decreasing longitudes by poleTheta. This is the transformation
+// applied by an ObliqueProjection to locations before they are projected by the original projection.
+func NewRotationFromPole(poleLat float64, poleLon float64, poleTheta float64) Rotation {
+	sinLat, cosLat := rightAngleSincos(poleLat)
+	sinLon, cosLon := rightAngleSincos(poleLon)
+	// the new axes: away from north at the pole, east at the pole, and the pole itself
+	xAxis := [3]float64{sinLat * cosLon, sinLat * sinLon, -cosLat}
+	yAxis := [3]float64{-sinLon, cosLon, 0}
+	zAxis := [3]float64{cosLat * cosLon, cosLat * sinLon, sinLat}
+
+	sinTheta, cosTheta := rightAngleSincos(poleTheta)
+	var r Rotation
+	for i := 0; i < 3; i++ {
+		r.m[0][i] = cosTheta*xAxis[i] + sinTheta*yAxis[i]
+		r.m[1][i] = -sinTheta*xAxis[i] + cosTheta*yAxis[i]
+		r.m[2][i] = zAxis[i]
+	}
+	return r
+}
+
+// The sine and cosine of an angle, exactly zero and one at multiples of a right angle, where the rounding of Pi
+// would otherwise leave a sine or cosine of about 1e-16 that turns the poles off their longitudes.
+func rightAngleSincos(angle float64) (float64, float64) {
+	quarters := angle / (math.Pi / 2)
+	if quarters != math.Trunc(quarters) || math.Abs(quarters) > 1<<52 {
+		return math.Sincos(angle)
+	}
+	switch int64(quarters) & 3 {
+	case 0:
+		return 0, 1
+	case 1:
+		return 1, 0
+	case 2:
+		return 0, -1
+	default:
+		return -1, 0
+	}
+}
+
+// The rotation which moves the location at the given yaw (longitude) and pitch (latitude) in radians to
+// latitude/longitude (0, 0), keeping the direction toward the north pole pointing north, then turns the
+// result by the given roll about (0, 0), with positive values moving the north pole toward positive
+// longitudes. This matches the conventional yaw, pitch and roll of a camera or vehicle looking along the x axis.
+func NewRotationFromYawPitchRoll(yaw float64, pitch float64, roll float64) Rotation {
+	return rotationAboutZ(-yaw).Compose(rotationAboutY(pitch)).Compose(rotationAboutX(-roll))
+}
+
+// The rotation which moves the given center location (in radians) to latitude/longitude (0, 0), with the
+// direction of the given azimuth at the center (in radians clockwise from north) becoming due north.
+func NewRotationFromCenter(lat float64, lon float64, azimuth float64) Rotation {
+	return NewRotationFromYawPitchRoll(lon, lat, -azimuth)
+}
+
+// The smallest rotation which moves the first location to the second, both in radians, turning about the
+// axis perpendicular to both. Antipodal locations are rotated about an arbitrary perpendicular axis.
+func NewRotationFromPoints(lat1 float64, lon1 float64, lat2 float64, lon2 float64) Rotation {
+	ax, ay, az := sphericalToCartesian(lat1, lon1)
+	bx, by, bz := sphericalToCartesian(lat2, lon2)
+	// the quaternion halfway between the identity and the double rotation from a to b
+	cx, cy, cz := cross(ax, ay, az, bx, by, bz)
+	w := 1 + ax*bx + ay*by + az*bz
+	if w < 1e-12 {
+		// any axis perpendicular to a will do
+		if math.Abs(ax) < 0.9 {
+			cx, cy, cz = cross(ax, ay, az, 1, 0, 0)
+		} else {
+			cx, cy, cz = cross(ax, ay, az, 0, 1, 0)
+		}
+		w = 0
+	}
+	return NewRotationFromQuaternion(w, cx, cy, cz)
+}
+
+// The rotation described by the given quaternion, which is normalized before use.
+func NewRotationFromQuaternion(w float64, x float64, y float64, z float64) Rotation {
+	norm := math.Sqrt(w*w + x*x + y*y + z*z)
+	w, x, y, z = w/norm, x/norm, y/norm, z/norm
+	return Rotation{[3][3]float64{
+		{1 - 2*(y*y+z*z), 2 * (x*y - w*z), 2 * (x*z + w*y)},
+		{2 * (x*y + w*z), 1 - 2*(x*x+z*z), 2 * (y*z - w*x)},
+		{2 * (x*z - w*y), 2 * (y*z + w*x), 1 - 2*(x*x+y*y)},
+	}}
+}
+
+// The unit quaternion describing the rotation, with a non-negative w component.
+func (r Rotation) Quaternion() (w float64, x float64, y float64, z float64) {
+	m := r.m
+	// pick the numerically largest component to divide by
+	trace := m[0][0] + m[1][1] + m[2][2]
+	switch {
+	case trace > 0:
+		s := 2 * math.Sqrt(1+trace)
+		w, x, y, z = s/4, (m[2][1]-m[1][2])/s, (m[0][2]-m[2][0])/s, (m[1][0]-m[0][1])/s
+	case m[0][0] > m[1][1] && m[0][0] > m[2][2]:
+		s := 2 * math.Sqrt(1+m[0][0]-m[1][1]-m[2][2])
+		w, x, y, z = (m[2][1]-m[1][2])/s, s/4, (m[0][1]+m[1][0])/s, (m[0][2]+m[2][0])/s
+	case m[1][1] > m[2][2]:
+		s := 2 * math.Sqrt(1+m[1][1]-m[0][0]-m[2][2])
+		w, x, y, z = (m[0][2]-m[2][0])/s, (m[0][1]+m[1][0])/s, s/4, (m[1][2]+m[2][1])/s
+	default:
+		s := 2 * math.Sqrt(1+m[2][2]-m[0][0]-m[1][1])
+		w, x, y, z = (m[1][0]-m[0][1])/s, (m[0][2]+m[2][0])/s, (m[1][2]+m[2][1])/s, s/4
+	}
+	if w < 0 {
+		return -w, -x, -y, -z
+	}
+	return w, x, y, z
+}
+
+// The rotation matrix, such that a unit vector v is rotated to Matrix() * v.
+func (r Rotation) Matrix() [3][3]float64 {
+	return r.m
+}
+
+// The rotation which undoes this rotation.
+func (r Rotation) Inverse() Rotation {
+	var inv Rotation
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			inv.m[i][j] = r.m[j][i]
+		}
+	}
+	return inv
+}
+
+// The rotation which applies this rotation, followed by the next rotation.
+func (r Rotation) Compose(next Rotation) Rotation {
+	var composed Rotation
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			composed.m[i][j] = next.m[i][0]*r.m[0][j] + next.m[i][1]*r.m[1][j] + next.m[i][2]*r.m[2][j]
+		}
+	}
+	return composed
+}
+
+// Rotate a location on the sphere, in radians. The rotated longitude is in (-Pi, Pi].
+func (r Rotation) Apply(lat float64, lon float64) (float64, float64) {
+	x, y, z := sphericalToCartesian(lat, lon)
+	return rotatedLatLon(r.ApplyVector(x, y, z))
+}
+
+// Rotate a location on the sphere, in radians, by the inverse of the rotation. The rotated longitude is in
+// (-Pi, Pi].
+func (r Rotation) ApplyInverse(lat float64, lon float64) (float64, float64) {
+	x, y, z := sphericalToCartesian(lat, lon)
+	return rotatedLatLon(r.ApplyInverseVector(x, y, z))
+}
+
+// The location of a rotated vector, placing 180 degrees at Pi rather than leaving it to the sign of a zero y,
+// which the rotation does not preserve.
+func rotatedLatLon(x float64, y float64, z float64) (float64, float64) {
+	lat, lon := cartesianToSpherical(x, y, z)
+	if lon == -math.Pi {
+		lon = math.Pi
+	}
+	return lat, lon
+}
+
+// Rotate a vector in the cartesian space around the sphere.
+func (r Rotation) ApplyVector(x float64, y float64, z float64) (float64, float64, float64) {
+	m := r.m
+	return m[0][0]*x + m[0][1]*y + m[0][2]*z,
+		m[1][0]*x + m[1][1]*y + m[1][2]*z,
+		m[2][0]*x + m[2][1]*y + m[2][2]*z
+}
+
+// Rotate a vector in the cartesian space around the sphere by the inverse of the rotation.
+func (r Rotation) ApplyInverseVector(x float64, y float64, z float64) (float64, float64, float64) {
+	m := r.m
+	return m[0][0]*x + m[1][0]*y + m[2][0]*z,
+		m[0][1]*x + m[1][1]*y + m[2][1]*z,
+		m[0][2]*x + m[1][2]*y + m[2][2]*z
+}
+
+// The pole location and turn which describe the rotation, as given to NewRotationFromPole.
+func (r Rotation) Pole() (poleLat float64, poleLon float64, poleTheta float64) {
+	poleLat, poleLon = r.ApplyInverse(math.Pi/2, 0)
+	// the direction away from north at the pole lands on longitude -poleTheta
+	_, offLon := r.Apply(NewRotationFromPole(poleLat, poleLon, 0).ApplyInverse(0, 0))
+	return poleLat, poleLon, -offLon
+}
+
+func rotationAboutX(angle float64) Rotation {
+	sin, cos := math.Sincos(angle)
+	return Rotation{[3][3]float64{{1, 0, 0}, {0, cos, -sin}, {0, sin, cos}}}
+}
+
+func rotationAboutY(angle float64) Rotation {
+	sin, cos := math.Sincos(angle)
+	return Rotation{[3][3]float64{{cos, 0, sin}, {0, 1, 0}, {-sin, 0, cos}}}
+}
+
+func rotationAboutZ(angle float64) Rotation {
+	sin, cos := math.Sincos(angle)
+	return Rotation{[3][3]float64{{cos, -sin, 0}, {sin, cos, 0}, {0, 0, 1}}}
+}
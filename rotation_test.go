@@ -0,0 +1,112 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func rotationsEqual(a Rotation, b Rotation, tolerance float64) bool {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if !withinTolerance(a.m[i][j], b.m[i][j], tolerance) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestRotationFromPole(t *testing.T) {
+	rot := NewRotationFromPole(0.6, -1.1, 0.4)
+	if lat, _ := rot.Apply(0.6, -1.1); !withinTolerance(lat, math.Pi/2, 0.000001) {
+		t.Errorf("expected pole to move to the north pole, got latitude %e", lat)
+	}
+	// turning about the pole decreases longitudes
+	unturned := NewRotationFromPole(0.6, -1.1, 0)
+	lat1, lon1 := unturned.Apply(0.1, 0.2)
+	lat2, lon2 := rot.Apply(0.1, 0.2)
+	if !withinTolerance(lat1, lat2, 0.000001) || !withinTolerance(coerceAngle(lon1-lon2), 0.4, 0.000001) {
+		t.Errorf("expected longitude decreased by 0.4, got %e,%e and %e,%e", lat1, lon1, lat2, lon2)
+	}
+
+	poleLat, poleLon, poleTheta := rot.Pole()
+	if !withinTolerance(poleLat, 0.6, 0.000001) || !withinTolerance(poleLon, -1.1, 0.000001) || !withinTolerance(poleTheta, 0.4, 0.000001) {
+		t.Errorf("expected pole 0.6,-1.1,0.4, got %e,%e,%e", poleLat, poleLon, poleTheta)
+	}
+}
+
+func TestRotationFromYawPitchRoll(t *testing.T) {
+	rot := NewRotationFromYawPitchRoll(2.0, -0.5, 0)
+	if lat, lon := rot.Apply(-0.5, 2.0); !withinTolerance(lat, 0, 0.000001) || !withinTolerance(lon, 0, 0.000001) {
+		t.Errorf("expected view direction at the origin, got %e,%e", lat, lon)
+	}
+	if lat, lon := rot.Apply(-0.4, 2.0); !withinTolerance(lat, 0.1, 0.000001) || !withinTolerance(lon, 0, 0.000001) {
+		t.Errorf("expected north of the view direction to stay north, got %e,%e", lat, lon)
+	}
+
+	rolled := NewRotationFromYawPitchRoll(2.0, -0.5, 0.3)
+	if lat, lon := rolled.Apply(-0.4, 2.0); !withinTolerance(lat, 0.1*math.Cos(0.3), 0.001) || lon <= 0 {
+		t.Errorf("expected north of the view direction to turn toward positive longitudes, got %e,%e", lat, lon)
+	}
+}
+
+func TestRotationFromCenter(t *testing.T) {
+	lat, lon, azimuth := 0.7, -2.2, 1.0
+	rot := NewRotationFromCenter(lat, lon, azimuth)
+	destLat, destLon := Destination(lat, lon, 0.2, azimuth)
+	if rlat, rlon := rot.Apply(destLat, destLon); !withinTolerance(rlat, 0.2, 0.000001) || !withinTolerance(rlon, 0, 0.000001) {
+		t.Errorf("expected the azimuth to point north from the origin, got %e,%e", rlat, rlon)
+	}
+}
+
+func TestRotationFromPoints(t *testing.T) {
+	testCases := []struct {
+		name string
+		from [2]float64
+		to   [2]float64
+	}{
+		{"Nearby", [2]float64{0.2, 0.3}, [2]float64{0.25, 0.4}},
+		{"Distant", [2]float64{-1.2, 2.9}, [2]float64{0.8, -0.3}},
+		{"Same", [2]float64{0.5, 0.5}, [2]float64{0.5, 0.5}},
+		{"Antipodal", [2]float64{0.4, 1.0}, [2]float64{-0.4, 1.0 - math.Pi}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rot := NewRotationFromPoints(tc.from[0], tc.from[1], tc.to[0], tc.to[1])
+			lat, lon := rot.Apply(tc.from[0], tc.from[1])
+			if !withinTolerance(lat, tc.to[0], 0.000001) || !withinTolerance(math.Cos(lon-tc.to[1]), 1, 0.000001) {
+				t.Errorf("expected %e,%e, got %e,%e", tc.to[0], tc.to[1], lat, lon)
+			}
+		})
+	}
+}
+
+func TestRotationAlgebra(t *testing.T) {
+	rot := NewRotationFromYawPitchRoll(0.3, 1.1, -2.0)
+	if !rotationsEqual(rot.Compose(rot.Inverse()), NewIdentityRotation(), 1e-12) {
+		t.Errorf("expected a rotation composed with its inverse to be the identity")
+	}
+	if !rotationsEqual(NewRotationFromQuaternion(rot.Quaternion()), rot, 1e-12) {
+		t.Errorf("expected the quaternion to describe the same rotation")
+	}
+
+	// applying two rotations in turn matches their composition
+	next := NewRotationFromPole(-0.2, 0.9, 1.3)
+	lat, lon := next.Apply(rot.Apply(0.4, -0.6))
+	clat, clon := rot.Compose(next).Apply(0.4, -0.6)
+	if !withinTolerance(lat, clat, 0.000001) || !withinTolerance(lon, clon, 0.000001) {
+		t.Errorf("expected %e,%e, got %e,%e", lat, lon, clat, clon)
+	}
+}
+
+func TestObliqueProjectionFromRotation(t *testing.T) {
+	byPole := NewObliqueProjection(NewMollweide(), 0.5, 1.5, -0.7)
+	byRotation := NewObliqueProjectionFromRotation(NewMollweide(), NewRotationFromPole(0.5, 1.5, -0.7))
+	for _, loc := range [][2]float64{{0.1, 0.2}, {-1.0, 2.5}, {1.3, -2.8}} {
+		x1, y1 := byPole.Project(loc[0], loc[1])
+		x2, y2 := byRotation.Project(loc[0], loc[1])
+		if !withinTolerance(x1, x2, 0.000001) || !withinTolerance(y1, y2, 0.000001) {
+			t.Errorf("expected %e,%e, got %e,%e", x1, y1, x2, y2)
+		}
+	}
+}
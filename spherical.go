@@ -0,0 +1,159 @@
+package flatsphere
+
+import "math"
+
+// Compute the great-circle distance between two locations on the unit sphere, given in radians. The result
+// is the central angle between the two locations, which is the distance in multiples of the sphere radius.
+// Uses the Vincenty formula, which is well conditioned for both small and antipodal separations.
+func GreatCircleDistance(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
+	sinLat1, cosLat1 := math.Sincos(lat1)
+	sinLat2, cosLat2 := math.Sincos(lat2)
+	sinDLon, cosDLon := math.Sincos(lon2 - lon1)
+
+	numer := math.Hypot(cosLat2*sinDLon, cosLat1*sinLat2-sinLat1*cosLat2*cosDLon)
+	denom := sinLat1*sinLat2 + cosLat1*cosLat2*cosDLon
+	return math.Atan2(numer, denom)
+}
+
+// Compute the great-circle distance between two locations on the unit sphere, given in radians, using the
+// haversine formula. Cheaper than GreatCircleDistance, but loses precision for nearly antipodal locations.
+func HaversineDistance(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
+	sinHalfDLat := math.Sin((lat2 - lat1) / 2)
+	sinHalfDLon := math.Sin((lon2 - lon1) / 2)
+	a := sinHalfDLat*sinHalfDLat + math.Cos(lat1)*math.Cos(lat2)*sinHalfDLon*sinHalfDLon
+	return 2 * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// The azimuth (in radians, clockwise from north) at the first location of the great circle path
+// leading to the second location. The result is in the range (-Pi, Pi].
+func InitialAzimuth(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
+	sinLat1, cosLat1 := math.Sincos(lat1)
+	sinLat2, cosLat2 := math.Sincos(lat2)
+	sinDLon, cosDLon := math.Sincos(lon2 - lon1)
+	return math.Atan2(sinDLon*cosLat2, cosLat1*sinLat2-sinLat1*cosLat2*cosDLon)
+}
+
+// The azimuth (in radians, clockwise from north) of the great circle path from the first location
+// at the moment it arrives at the second location. The result is in the range [-Pi, Pi).
+func FinalAzimuth(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
+	return coerceAngle(InitialAzimuth(lat2, lon2, lat1, lon1) + math.Pi)
+}
+
+// Find the location reached by travelling the given angular distance (in multiples of the sphere radius)
+// along a great circle from the starting location, departing at the given azimuth (clockwise from north).
+func Destination(lat float64, lon float64, distance float64, azimuth float64) (float64, float64) {
+	sinLat, cosLat := math.Sincos(lat)
+	sinDist, cosDist := math.Sincos(distance)
+	sinAz, cosAz := math.Sincos(azimuth)
+
+	destLat := math.Asin(clampUnit(sinLat*cosDist + cosLat*sinDist*cosAz))
+	destLon := lon + math.Atan2(sinAz*sinDist*cosLat, cosDist-sinLat*math.Sin(destLat))
+	return destLat, coerceAngle(destLon)
+}
+
+// Find the location that lies the given fraction of the way along the great circle from the first location
+// to the second. A fraction of 0 returns the first location and 1 returns the second. The path between
+// antipodal locations is undefined, and results in NaN.
+func IntermediatePoint(lat1 float64, lon1 float64, lat2 float64, lon2 float64, fraction float64) (float64, float64) {
+	delta := GreatCircleDistance(lat1, lon1, lat2, lon2)
+	if delta == 0 {
+		return lat1, lon1
+	}
+	sinDelta := math.Sin(delta)
+	a := math.Sin((1-fraction)*delta) / sinDelta
+	b := math.Sin(fraction*delta) / sinDelta
+
+	x1, y1, z1 := sphericalToCartesian(lat1, lon1)
+	x2, y2, z2 := sphericalToCartesian(lat2, lon2)
+	return cartesianToSpherical(a*x1+b*x2, a*y1+b*y2, a*z1+b*z2)
+}
+
+// Generate count+1 evenly spaced locations along the great circle from the first location to the second,
+// including both endpoints. Results are returned as a slice of latitude/longitude pairs. A count below 1 is
+// treated as 1, giving just the endpoints.
+func GreatCirclePath(lat1 float64, lon1 float64, lat2 float64, lon2 float64, count int) [][2]float64 {
+	if count < 1 {
+		count = 1
+	}
+	path := make([][2]float64, count+1)
+	for i := 0; i <= count; i++ {
+		lat, lon := IntermediatePoint(lat1, lon1, lat2, lon2, float64(i)/float64(count))
+		path[i] = [2]float64{lat, lon}
+	}
+	return path
+}
+
+// The signed angular distance of a location from the great circle passing through the start and end locations.
+// Positive values are to the right of the path when travelling from start to end, negative to the left.
+func CrossTrackDistance(lat float64, lon float64, startLat float64, startLon float64, endLat float64, endLon float64) float64 {
+	dist13 := GreatCircleDistance(startLat, startLon, lat, lon)
+	az13 := InitialAzimuth(startLat, startLon, lat, lon)
+	az12 := InitialAzimuth(startLat, startLon, endLat, endLon)
+	return math.Asin(clampUnit(math.Sin(dist13) * math.Sin(az13-az12)))
+}
+
+// The angular distance from the start location along the great circle towards the end location, to the point
+// on the great circle closest to the given location.
+func AlongTrackDistance(lat float64, lon float64, startLat float64, startLon float64, endLat float64, endLon float64) float64 {
+	dist13 := GreatCircleDistance(startLat, startLon, lat, lon)
+	xt := CrossTrackDistance(lat, lon, startLat, startLon, endLat, endLon)
+	along := math.Acos(clampUnit(math.Cos(dist13) / math.Cos(xt)))
+	az13 := InitialAzimuth(startLat, startLon, lat, lon)
+	az12 := InitialAzimuth(startLat, startLon, endLat, endLon)
+	if math.Cos(az12-az13) < 0 {
+		return -along
+	}
+	return along
+}
+
+// Find the intersection of two great circles, each defined by a location and an azimuth (clockwise from north)
+// at that location. Two great circles always intersect at a pair of antipodal points; the one returned is the
+// point closer to the first location along its direction of travel. Returns NaN if the great circles coincide.
+func GreatCircleIntersection(lat1 float64, lon1 float64, azimuth1 float64, lat2 float64, lon2 float64, azimuth2 float64) (float64, float64) {
+	n1x, n1y, n1z := greatCircleNormal(lat1, lon1, azimuth1)
+	n2x, n2y, n2z := greatCircleNormal(lat2, lon2, azimuth2)
+
+	ix, iy, iz := cross(n1x, n1y, n1z, n2x, n2y, n2z)
+	if math.Sqrt(ix*ix+iy*iy+iz*iz) < 1e-12 {
+		return math.NaN(), math.NaN()
+	}
+
+	// choose the intersection lying ahead of the first location along its heading
+	px, py, pz := sphericalToCartesian(lat1, lon1)
+	dx, dy, dz := cross(n1x, n1y, n1z, px, py, pz)
+	if dx*ix+dy*iy+dz*iz < 0 {
+		ix, iy, iz = -ix, -iy, -iz
+	}
+	return cartesianToSpherical(ix, iy, iz)
+}
+
+// The normal vector of the plane of the great circle passing through the location with the given azimuth.
+func greatCircleNormal(lat float64, lon float64, azimuth float64) (float64, float64, float64) {
+	sinLat, cosLat := math.Sincos(lat)
+	sinLon, cosLon := math.Sincos(lon)
+	sinAz, cosAz := math.Sincos(azimuth)
+	// heading vector, composed from the local north and east unit vectors
+	dx := -cosAz*sinLat*cosLon - sinAz*sinLon
+	dy := -cosAz*sinLat*sinLon + sinAz*cosLon
+	dz := cosAz * cosLat
+	return cross(cosLat*cosLon, cosLat*sinLon, sinLat, dx, dy, dz)
+}
+
+func sphericalToCartesian(lat float64, lon float64) (float64, float64, float64) {
+	sinLat, cosLat := math.Sincos(lat)
+	sinLon, cosLon := math.Sincos(lon)
+	return cosLat * cosLon, cosLat * sinLon, sinLat
+}
+
+func cartesianToSpherical(x float64, y float64, z float64) (float64, float64) {
+	return math.Atan2(z, math.Hypot(x, y)), math.Atan2(y, x)
+}
+
+func cross(ax, ay, az, bx, by, bz float64) (float64, float64, float64) {
+	return ay*bz - az*by, az*bx - ax*bz, ax*by - ay*bx
+}
+
+// Clamp a value into [-1, 1], guarding inverse trig functions against roundoff error.
+func clampUnit(v float64) float64 {
+	return math.Max(-1, math.Min(1, v))
+}
@@ -0,0 +1,121 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGreatCircleDistance(t *testing.T) {
+	testCases := []struct {
+		name     string
+		lat1     float64
+		lon1     float64
+		lat2     float64
+		lon2     float64
+		expected float64
+	}{
+		{"Same", math.Pi / 4, math.Pi / 4, math.Pi / 4, math.Pi / 4, 0},
+		{"QuarterEquator", 0, 0, 0, math.Pi / 2, math.Pi / 2},
+		{"EquatorToPole", 0, 1, math.Pi / 2, 0, math.Pi / 2},
+		{"Antipodal", 0, 0, 0, math.Pi, math.Pi},
+		{"PoleToPole", math.Pi / 2, 0, -math.Pi / 2, 0, math.Pi},
+		{"AcrossAntimeridian", 0, math.Pi - 0.1, 0, -math.Pi + 0.1, 0.2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dist := GreatCircleDistance(tc.lat1, tc.lon1, tc.lat2, tc.lon2)
+			if !withinTolerance(dist, tc.expected, 0.000001) {
+				t.Errorf("expected distance %e, got %e", tc.expected, dist)
+			}
+			hav := HaversineDistance(tc.lat1, tc.lon1, tc.lat2, tc.lon2)
+			if !withinTolerance(hav, tc.expected, 0.000001) {
+				t.Errorf("expected haversine distance %e, got %e", tc.expected, hav)
+			}
+		})
+	}
+}
+
+func TestAzimuths(t *testing.T) {
+	if az := InitialAzimuth(0, 0, 0, math.Pi/2); !withinTolerance(az, math.Pi/2, 0.000001) {
+		t.Errorf("expected due east azimuth, got %e", az)
+	}
+	if az := InitialAzimuth(0, 0, math.Pi/4, 0); !withinTolerance(az, 0, 0.000001) {
+		t.Errorf("expected due north azimuth, got %e", az)
+	}
+	// leaving the equator at 45 degrees, the path arrives at the vertex heading due east
+	lat, lon := Destination(0, 0, math.Pi/2, math.Pi/4)
+	if az := FinalAzimuth(0, 0, lat, lon); !withinTolerance(az, math.Pi/2, 0.000001) {
+		t.Errorf("expected final azimuth due east, got %e", az)
+	}
+}
+
+func FuzzDestinationRoundTrip(f *testing.F) {
+	f.Add(0.0, 0.0, 1.0, 0.5)
+	f.Add(math.Pi/4, -math.Pi/2, 0.25, -2.0)
+	f.Add(-1.2, 3.0, 2.5, 3.0)
+	f.Fuzz(func(t *testing.T, lat float64, lon float64, dist float64, az float64) {
+		lat = math.Mod(lat, math.Pi/2*0.99)
+		lon = math.Mod(lon, math.Pi)
+		dist = math.Abs(math.Mod(dist, math.Pi*0.99))
+		az = math.Mod(az, math.Pi)
+		if dist < 1e-6 || math.IsNaN(lat+lon+dist+az) {
+			t.Skip()
+		}
+
+		dLat, dLon := Destination(lat, lon, dist, az)
+		if got := GreatCircleDistance(lat, lon, dLat, dLon); !withinTolerance(got, dist, 0.000001) {
+			t.Errorf("expected destination at distance %e, got %e", dist, got)
+		}
+		if got := InitialAzimuth(lat, lon, dLat, dLon); !withinTolerance(math.Cos(got), math.Cos(az), 0.00001) || !withinTolerance(math.Sin(got), math.Sin(az), 0.00001) {
+			t.Errorf("expected destination at azimuth %e, got %e", az, got)
+		}
+	})
+}
+
+func TestIntermediatePoint(t *testing.T) {
+	lat, lon := IntermediatePoint(0, 0, 0, math.Pi/2, 0.5)
+	if !withinTolerance(lat, 0, 0.000001) || !withinTolerance(lon, math.Pi/4, 0.000001) {
+		t.Errorf("expected midpoint 0,%e, got %e,%e", math.Pi/4, lat, lon)
+	}
+
+	path := GreatCirclePath(0, 0, math.Pi/2, 0, 4)
+	if len(path) != 5 {
+		t.Fatalf("expected 5 path points, got %d", len(path))
+	}
+	for i, p := range path {
+		if !withinTolerance(p[0], float64(i)*math.Pi/8, 0.000001) {
+			t.Errorf("expected path point %d at lat %e, got %e", i, float64(i)*math.Pi/8, p[0])
+		}
+	}
+	for _, count := range []int{0, -3} {
+		if path := GreatCirclePath(0, 0, math.Pi/2, 0, count); len(path) != 2 || path[0] != [2]float64{0, 0} || !withinTolerance(path[1][0], math.Pi/2, 0.000001) {
+			t.Errorf("expected a count of %d to give just the endpoints, got %v", count, path)
+		}
+	}
+}
+
+func TestCrossAndAlongTrack(t *testing.T) {
+	// path along the equator heading east, point north of it is to the left
+	xt := CrossTrackDistance(0.1, 0.5, 0, 0, 0, 1)
+	if !withinTolerance(xt, -0.1, 0.000001) {
+		t.Errorf("expected cross track -0.1, got %e", xt)
+	}
+	at := AlongTrackDistance(0.1, 0.5, 0, 0, 0, 1)
+	if !withinTolerance(at, 0.5, 0.000001) {
+		t.Errorf("expected along track 0.5, got %e", at)
+	}
+}
+
+func TestGreatCircleIntersection(t *testing.T) {
+	// the equator heading east, and the meridian at Pi/4 heading south from the north
+	lat, lon := GreatCircleIntersection(0, 0, math.Pi/2, math.Pi/4, math.Pi/4, math.Pi)
+	if !withinTolerance(lat, 0, 0.000001) || !withinTolerance(lon, math.Pi/4, 0.000001) {
+		t.Errorf("expected intersection 0,%e, got %e,%e", math.Pi/4, lat, lon)
+	}
+
+	lat, lon = GreatCircleIntersection(0, 0, math.Pi/2, 0, 1, math.Pi/2)
+	if !math.IsNaN(lat) || !math.IsNaN(lon) {
+		t.Errorf("expected coincident great circles to have no unique intersection, got %e,%e", lat, lon)
+	}
+}
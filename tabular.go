@@ -16,6 +16,7 @@ type TabularProjection struct {
 	latitudes           []float64
 	parallelLengthRatio []float64
 	parallelDistRatio   []float64
+	metadata            Metadata
 }
 
 // Create a new pseudocylindrical projection from a table of values. The tables should be sorted by the latitude
@@ -29,7 +30,14 @@ func NewTabularProjection(
 	polynomialOrder int,
 	yScale float64,
 ) TabularProjection {
-	return TabularProjection{polynomialOrder / 2, yScale, latitudes, parallelLengthRatios, parallelDistanceRatios}
+	return TabularProjection{
+		polynomialOrder / 2,
+		yScale,
+		latitudes,
+		parallelLengthRatios,
+		parallelDistanceRatios,
+		Metadata{Name: "Tabular pseudocylindrical", Family: FamilyPseudocylindrical, Shape: ShapeFlatPolar},
+	}
 }
 
 var (
@@ -43,13 +51,19 @@ var (
 // Create a new Robinson projection, a well-known instance of a pseudocylindrical projection defined by a table of values.
 // https://en.wikipedia.org/wiki/Robinson_projection
 func NewRobinson() TabularProjection {
-	return NewTabularProjection(robinsonNaturalEarthLatitudes, robinsonLengthRatios, robinsonDistRatios, 4, 0.5072)
+	robinson := NewTabularProjection(robinsonNaturalEarthLatitudes, robinsonLengthRatios, robinsonDistRatios, 4, 0.5072)
+	robinson.metadata.Name = "Robinson"
+	robinson.metadata.PROJName = "robin"
+	return robinson
 }
 
 // Create a new Natural Earth projection, a well-known instance of a pseudocylindrical projection defined by a table of values.
 // https://en.wikipedia.org/wiki/Natural_Earth_projection
 func NewNaturalEarth() TabularProjection {
-	return NewTabularProjection(robinsonNaturalEarthLatitudes, naturalEarthLengthRatios, naturalEarthDistRatios, 4, 0.520)
+	naturalEarth := NewTabularProjection(robinsonNaturalEarthLatitudes, naturalEarthLengthRatios, naturalEarthDistRatios, 4, 0.520)
+	naturalEarth.metadata.Name = "Natural Earth"
+	naturalEarth.metadata.PROJName = "natearth"
+	return naturalEarth
 }
 
 func (t TabularProjection) Project(lat float64, lon float64) (float64, float64) {
@@ -74,3 +88,7 @@ func (t TabularProjection) interpolate(at float64, xs []float64, ys []float64) f
 func (t TabularProjection) PlanarBounds() Bounds {
 	return NewRectangleBounds(2, t.yScale*2)
 }
+
+func (t TabularProjection) Describe() Metadata {
+	return t.metadata
+}
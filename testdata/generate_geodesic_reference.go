@@ -0,0 +1,336 @@
+// Generates geodesic-reference.dat.gz, a reference dataset of geodesics on the WGS84 ellipsoid for the tests of
+// the geodesic solver, computed independently of it: each direct problem is solved on the auxiliary sphere in
+// 256-bit floating point, integrating the distance and longitude integrands of Karney (2013), "Algorithms for
+// geodesics", equations 7 and 8, by their Fourier series, with the coefficients found from samples by the discrete
+// Fourier transform rather than from the series expansions in the flattening that the solver uses.
+//
+// Each line holds lat1 lon1 azi1 lat2 lon2 azi2 s12 a12, with angles in degrees and lengths in meters, like the
+// first eight fields of Karney's GeodTest datasets. Every geodesic stays well away from the antipodal cut locus of
+// its start, so that it is the shortest between its ends.
+//
+// Run from the repository root with: go run testdata/generate_geodesic_reference.go
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"os"
+)
+
+const prec = 256
+
+// The number of samples of the integrands over their period of Pi, and the number of Fourier terms kept. The
+// terms shrink by a factor of about e'²/4 each, so far fewer than these are needed at 256 bits.
+const (
+	samples = 128
+	terms   = 60
+)
+
+func num(x float64) *big.Float {
+	return new(big.Float).SetPrec(prec).SetFloat64(x)
+}
+
+func parse(s string) *big.Float {
+	f, _, err := big.ParseFloat(s, 10, prec, big.ToNearestEven)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+func add(a, b *big.Float) *big.Float { return new(big.Float).SetPrec(prec).Add(a, b) }
+func sub(a, b *big.Float) *big.Float { return new(big.Float).SetPrec(prec).Sub(a, b) }
+func mul(a, b *big.Float) *big.Float { return new(big.Float).SetPrec(prec).Mul(a, b) }
+func quo(a, b *big.Float) *big.Float { return new(big.Float).SetPrec(prec).Quo(a, b) }
+func sqrt(a *big.Float) *big.Float   { return new(big.Float).SetPrec(prec).Sqrt(a) }
+func neg(a *big.Float) *big.Float    { return new(big.Float).SetPrec(prec).Neg(a) }
+
+// Whether a term is too small to change a sum of order one.
+func negligible(term *big.Float) bool {
+	return term.Sign() == 0 || term.MantExp(nil) < -prec-8
+}
+
+// The arctangent by its Taylor series, for small arguments.
+func atanSeries(x *big.Float) *big.Float {
+	sum, power, x2 := num(0).Set(x), num(0).Set(x), mul(x, x)
+	for n := 1; ; n++ {
+		power = neg(mul(power, x2))
+		term := quo(power, num(float64(2*n+1)))
+		if negligible(term) {
+			return sum
+		}
+		sum = add(sum, term)
+	}
+}
+
+// Machin's formula.
+var pi = sub(mul(num(16), atanSeries(quo(num(1), num(5)))), mul(num(4), atanSeries(quo(num(1), num(239)))))
+
+func atan(x *big.Float) *big.Float {
+	if x.Sign() < 0 {
+		return neg(atan(neg(x)))
+	}
+	if x.Cmp(num(1)) > 0 {
+		return sub(quo(pi, num(2)), atan(quo(num(1), x)))
+	}
+	// halve the angle three times, to below tan(Pi/32)
+	for i := 0; i < 3; i++ {
+		x = quo(x, add(num(1), sqrt(add(num(1), mul(x, x)))))
+	}
+	return mul(num(8), atanSeries(x))
+}
+
+func atan2(y, x *big.Float) *big.Float {
+	switch {
+	case x.Sign() > 0:
+		return atan(quo(y, x))
+	case x.Sign() < 0 && y.Sign() >= 0:
+		return add(atan(quo(y, x)), pi)
+	case x.Sign() < 0:
+		return sub(atan(quo(y, x)), pi)
+	case y.Sign() > 0:
+		return quo(pi, num(2))
+	case y.Sign() < 0:
+		return neg(quo(pi, num(2)))
+	}
+	return num(0)
+}
+
+// The sine and cosine by their Taylor series, after reducing the argument by quarter turns.
+func sincos(x *big.Float) (*big.Float, *big.Float) {
+	halfPi := quo(pi, num(2))
+	quarters, _ := quo(x, halfPi).Float64()
+	n := math.Round(quarters)
+	r := sub(x, mul(num(n), halfPi))
+	sin, cos, term := num(0).Set(r), num(1), num(1)
+	for k := 1; ; k++ {
+		term = quo(mul(term, r), num(float64(k)))
+		if negligible(term) {
+			break
+		}
+		// the terms r^k / k! alternate between the series in pairs
+		switch k % 4 {
+		case 1:
+			if k > 1 {
+				sin = add(sin, term)
+			}
+		case 2:
+			cos = sub(cos, term)
+		case 3:
+			sin = sub(sin, term)
+		case 0:
+			cos = add(cos, term)
+		}
+	}
+	switch int(n) & 3 {
+	case 1:
+		return cos, neg(sin)
+	case 2:
+		return neg(sin), neg(cos)
+	case 3:
+		return neg(cos), sin
+	}
+	return sin, cos
+}
+
+func degrees(x *big.Float) *big.Float { return quo(mul(x, num(180)), pi) }
+func radians(x *big.Float) *big.Float { return quo(mul(x, pi), num(180)) }
+
+// The integral from zero of an even function with period Pi, given by its values at the samples, as the
+// coefficient of its linear growth and the coefficients of sin(2nσ) for n from 1.
+type integral struct {
+	linear *big.Float
+	sines  [terms + 1]*big.Float
+}
+
+var sampleCos [samples]*big.Float // cos(2Pi m / samples), the cosines of 2nσ at the samples reduced mod the period
+
+func init() {
+	for m := range sampleCos {
+		_, sampleCos[m] = sincos(quo(mul(num(2*float64(m)), pi), num(samples)))
+	}
+}
+
+func newIntegral(values [samples]*big.Float) integral {
+	var result integral
+	sum := num(0)
+	for _, v := range values {
+		sum = add(sum, v)
+	}
+	result.linear = quo(sum, num(samples))
+	for n := 1; n <= terms; n++ {
+		coefficient := num(0)
+		for j, v := range values {
+			coefficient = add(coefficient, mul(v, sampleCos[n*j%samples]))
+		}
+		// the coefficient of cos(2nσ) is twice the mean, and its integral divides it by 2n
+		result.sines[n] = quo(coefficient, num(float64(samples*n)))
+	}
+	return result
+}
+
+// The integral from zero to σ.
+func (in integral) at(sigma *big.Float) *big.Float {
+	sin2, cos2 := sincos(mul(num(2), sigma))
+	total := mul(in.linear, sigma)
+	// sin(2nσ) by the recurrence sin(2(n+1)σ) = 2 cos(2σ) sin(2nσ) - sin(2(n-1)σ)
+	previous, current := num(0), sin2
+	for n := 1; n <= terms; n++ {
+		total = add(total, mul(in.sines[n], current))
+		previous, current = current, sub(mul(mul(num(2), cos2), current), previous)
+	}
+	return total
+}
+
+var (
+	a      = num(6378137)
+	f      = quo(num(1), parse("298.257223563"))
+	b      = mul(a, sub(num(1), f))
+	ep2    = quo(sub(mul(a, a), mul(b, b)), mul(b, b))
+	sinSig [samples]*big.Float // sin²(jPi / samples), the samples of sin²σ
+)
+
+func init() {
+	for j := range sinSig {
+		s, _ := sincos(quo(mul(num(float64(j)), pi), num(samples)))
+		sinSig[j] = mul(s, s)
+	}
+}
+
+// The direct geodesic problem, from the start in degrees and the distance in meters, giving the end in degrees
+// and the arc length on the auxiliary sphere in degrees.
+func direct(lat1, azi1, s12 *big.Float) (lat2, lon2, azi2, a12 *big.Float) {
+	one := num(1)
+	sinLat1, cosLat1 := sincos(radians(lat1))
+	// the reduced latitude, from tan β = (1 - f) tan φ
+	beta1 := atan2(mul(sub(one, f), sinLat1), cosLat1)
+	sinBeta1, cosBeta1 := sincos(beta1)
+	sinAzi1, cosAzi1 := sincos(radians(azi1))
+	sinAlpha0 := mul(sinAzi1, cosBeta1)
+	cosAlpha0 := sqrt(sub(one, mul(sinAlpha0, sinAlpha0)))
+	sigma1 := atan2(sinBeta1, mul(cosAzi1, cosBeta1))
+	k2 := mul(ep2, mul(cosAlpha0, cosAlpha0))
+
+	var distance, longitude [samples]*big.Float
+	for j := range distance {
+		root := sqrt(add(one, mul(k2, sinSig[j])))
+		distance[j] = root
+		longitude[j] = quo(sub(num(2), f), add(one, mul(sub(one, f), root)))
+	}
+	distanceIntegral, longitudeIntegral := newIntegral(distance), newIntegral(longitude)
+
+	// Newton's method for the arc length reaching the distance, s / b = I(σ2) - I(σ1)
+	target := add(distanceIntegral.at(sigma1), quo(s12, b))
+	sigma2 := add(sigma1, quo(quo(s12, b), distanceIntegral.linear))
+	for i := 0; i < 50; i++ {
+		s, _ := sincos(sigma2)
+		derivative := sqrt(add(one, mul(k2, mul(s, s))))
+		step := quo(sub(distanceIntegral.at(sigma2), target), derivative)
+		sigma2 = sub(sigma2, step)
+		if negligible(step) {
+			break
+		}
+	}
+
+	sinSigma1, cosSigma1 := sincos(sigma1)
+	sinSigma2, cosSigma2 := sincos(sigma2)
+	sinBeta2 := mul(cosAlpha0, sinSigma2)
+	cosBeta2 := sqrt(add(mul(sinAlpha0, sinAlpha0), mul(mul(cosAlpha0, cosSigma2), mul(cosAlpha0, cosSigma2))))
+	lat2 = degrees(atan2(sinBeta2, mul(sub(one, f), cosBeta2)))
+	azi2 = degrees(atan2(sinAlpha0, mul(cosAlpha0, cosSigma2)))
+
+	// the longitude on the auxiliary sphere, ω = atan2(sin α0 sin σ, cos σ), from σ1 to σ2, which stays below a
+	// half turn for the geodesics generated here
+	omega12 := atan2(
+		mul(sinAlpha0, sub(mul(sinSigma2, cosSigma1), mul(cosSigma2, sinSigma1))),
+		add(mul(cosSigma2, cosSigma1), mul(mul(sinAlpha0, sinAlpha0), mul(sinSigma2, sinSigma1))))
+	lambda12 := sub(omega12, mul(mul(f, sinAlpha0), sub(longitudeIntegral.at(sigma2), longitudeIntegral.at(sigma1))))
+	return lat2, degrees(lambda12), azi2, degrees(sub(sigma2, sigma1))
+}
+
+// Checks the generator against the direct problem of the WGS84 reference cases of the solver's own tests, from
+// GeographicLib, so that a mistake here is not mistaken for one in the solver.
+func check() {
+	for _, c := range []struct {
+		lat1, lon1, azi1, s12 string
+		lat2, lon2, azi2      float64
+	}{
+		{"-41.32", "174.81", "161.06766998615882", "19959679.26735382", 40.96, -5.50 + 360, 18.825195123248392},
+		{"0", "0", "90", "10018754.171394622", 0, 90, 90},
+		{"0", "0", "0", "10001965.7293127228", 90, 0, 0},
+	} {
+		lat2, lon2, azi2, _ := direct(parse(c.lat1), parse(c.azi1), parse(c.s12))
+		gotLat, _ := lat2.Float64()
+		gotLon, _ := add(lon2, parse(c.lon1)).Float64()
+		gotAzi, _ := azi2.Float64()
+		if math.Abs(gotLat-c.lat2) > 1e-12 || math.Abs(gotLon-c.lon2) > 1e-12 || math.Abs(gotAzi-c.azi2) > 1e-12 {
+			panic(fmt.Sprintf("expected %v,%v,%v, got %v,%v,%v", c.lat2, c.lon2, c.azi2, gotLat, gotLon, gotAzi))
+		}
+	}
+}
+
+func main() {
+	check()
+	file, err := os.Create("testdata/geodesic-reference.dat.gz")
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+	zipped := gzip.NewWriter(file)
+	defer zipped.Close()
+	out := bufio.NewWriter(zipped)
+	defer out.Flush()
+
+	random := rand.New(rand.NewSource(1))
+	uniform := func(low, high float64) float64 { return low + (high-low)*random.Float64() }
+	categories := []struct {
+		count int
+		start func() (lat1 float64, azi1 float64, s12 float64)
+	}{
+		// anywhere, in any direction, for any distance
+		{600, func() (float64, float64, float64) {
+			return math.Asin(uniform(-1, 1)) * 180 / math.Pi, uniform(0, 180), uniform(0, 20000000)
+		}},
+		// short, down to a millimeter
+		{150, func() (float64, float64, float64) {
+			return math.Asin(uniform(-1, 1)) * 180 / math.Pi, uniform(0, 180), math.Pow(10, uniform(-3, 4))
+		}},
+		// from near the poles
+		{100, func() (float64, float64, float64) {
+			return math.Copysign(uniform(89, 90), uniform(-1, 1)), uniform(0, 180), uniform(0, 20000000)
+		}},
+		// nearly along the equator
+		{100, func() (float64, float64, float64) {
+			return uniform(-0.01, 0.01), uniform(89.99, 90.01), uniform(0, 20000000)
+		}},
+		// nearly along a meridian
+		{50, func() (float64, float64, float64) {
+			return math.Asin(uniform(-1, 1)) * 180 / math.Pi, uniform(0, 0.01), uniform(0, 20000000)
+		}},
+	}
+	for _, category := range categories {
+		for written := 0; written < category.count; {
+			latitude, azimuth, distance := category.start()
+			lat1, azi1, s12 := fmt.Sprintf("%.10f", latitude), fmt.Sprintf("%.10f", azimuth), fmt.Sprintf("%.6f", distance)
+			if azimuth <= 0 || azimuth >= 180 || math.Abs(latitude) >= 90 || distance <= 0 {
+				continue
+			}
+			lat2, lon2, azi2, a12 := direct(parse(lat1), parse(azi1), parse(s12))
+			// the cut locus lies within about Pi f of the antipodal meridian, so keep well short of it, and of a
+			// half turn on the auxiliary sphere
+			if longitude, _ := lon2.Float64(); longitude < 0 || longitude > 177 {
+				continue
+			}
+			if arc, _ := a12.Float64(); arc > 177 {
+				continue
+			}
+			fmt.Fprintf(out, "%s 0 %s %s %s %s %s %s\n", lat1, azi1, lat2.Text('f', 15), lon2.Text('f', 15),
+				azi2.Text('f', 15), s12, a12.Text('f', 15))
+			written++
+		}
+	}
+}
@@ -0,0 +1,295 @@
+package flatsphere
+
+import (
+	"math"
+)
+
+// A projection in which the distances from two control points to every other location are true, and the
+// control points lie on the x axis either side of the origin. The whole sphere fits in an ellipse with the
+// control points as its foci.
+// https://en.wikipedia.org/wiki/Two-point_equidistant_projection
+type TwoPointEquidistant struct {
+	Lat1 float64 // The latitude (in radians) of the first control point, on the negative x axis.
+	Lon1 float64 // The longitude (in radians) of the first control point, on the negative x axis.
+	Lat2 float64 // The latitude (in radians) of the second control point, on the positive x axis.
+	Lon2 float64 // The longitude (in radians) of the second control point, on the positive x axis.
+
+	first   Vec3    // cached unit vector of the first control point
+	second  Vec3    // cached unit vector of the second control point
+	normal  Vec3    // cached unit normal of the great circle through the control points
+	dist    float64 // cached distance between the control points
+	azimuth float64 // cached azimuth from the first control point to the second
+}
+
+// Construct a two-point equidistant projection, where the control points must be distinct and not antipodal.
+func NewTwoPointEquidistant(lat1 float64, lon1 float64, lat2 float64, lon2 float64) TwoPointEquidistant {
+	first, second := NewVec3FromLatLon(lat1, lon1), NewVec3FromLatLon(lat2, lon2)
+	normal := first.Cross(second)
+	if normal.Norm() < 1e-12 {
+		panic("the control points of a TwoPointEquidistant projection must be distinct and not antipodal")
+	}
+	return TwoPointEquidistant{
+		Lat1:    lat1,
+		Lon1:    lon1,
+		Lat2:    lat2,
+		Lon2:    lon2,
+		first:   first,
+		second:  second,
+		normal:  normal.Normalize(),
+		dist:    first.AngleTo(second),
+		azimuth: InitialAzimuth(lat1, lon1, lat2, lon2),
+	}
+}
+
+func (t TwoPointEquidistant) Project(latitude float64, longitude float64) (float64, float64) {
+	p := NewVec3FromLatLon(latitude, longitude)
+	z1, z2 := t.first.AngleTo(p), t.second.AngleTo(p)
+	x := (z1 - z2) * (z1 + z2) / (2 * t.dist)
+	// the distance from the first control point along the x axis, then the height of the triangle
+	along := x + t.dist/2
+	y := math.Sqrt(math.Max(0, (z1-along)*(z1+along)))
+	if t.normal.Dot(p) < 0 {
+		y = -y
+	}
+	return withinEllipse(x, y, math.Pi-t.dist/2, math.Sqrt(math.Pi*(math.Pi-t.dist)))
+}
+
+func (t TwoPointEquidistant) Inverse(x float64, y float64) (float64, float64) {
+	z1, z2 := math.Hypot(x+t.dist/2, y), math.Hypot(x-t.dist/2, y)
+	// the angle at the first control point of the spherical triangle, by the half-angle formula
+	s := (z1 + z2 + t.dist) / 2
+	angle := 2 * math.Atan2(
+		math.Sqrt(math.Max(0, math.Sin(s-z1)*math.Sin(s-t.dist))),
+		math.Sqrt(math.Max(0, math.Sin(s)*math.Sin(s-z2))))
+	if math.Signbit(y) {
+		angle = -angle
+	}
+	return Destination(t.Lat1, t.Lon1, z1, t.azimuth-angle)
+}
+
+// The locations at a total distance of 2Pi less the distance between the control points form an ellipse, with
+// the antipodes of the control points at the ends of its major axis.
+func (t TwoPointEquidistant) PlanarBounds() Bounds {
+	return NewEllipseBounds(math.Pi-t.dist/2, math.Sqrt(math.Pi*(math.Pi-t.dist)))
+}
+
+func (t TwoPointEquidistant) Describe() Metadata {
+	return Metadata{
+		Name:       "Two-point equidistant",
+		Aliases:    []string{"Doubly equidistant"},
+		Family:     FamilyAzimuthal,
+		Properties: Equidistant,
+		Shape:      ShapeEllipse,
+		Extent:     ExtentWorld,
+		PROJName:   "tpeqd",
+	}
+}
+
+// A compromise projection in which the distances from three control points are nearly true, found as the
+// average of the three points at the true distances from each pair of control points. The control points form
+// a triangle with true sides, with the first two on a line parallel to the x axis and its centroid at the
+// origin, and the projection folds over itself far from them.
+// https://en.wikipedia.org/wiki/Chamberlin_trimetric_projection
+type ChamberlinTrimetric struct {
+	Lat1 float64 // The latitude (in radians) of the first control point.
+	Lon1 float64 // The longitude (in radians) of the first control point.
+	Lat2 float64 // The latitude (in radians) of the second control point.
+	Lon2 float64 // The longitude (in radians) of the second control point.
+	Lat3 float64 // The latitude (in radians) of the third control point.
+	Lon3 float64 // The longitude (in radians) of the third control point.
+
+	controls [3]Vec3             // cached unit vectors of the control points
+	planar   [3][2]float64       // cached positions of the control points on the plane
+	dists    [3]float64          // cached distances from each control point to the next
+	guess    TwoPointEquidistant // cached projection on the first two control points, to start the inverse
+}
+
+// Construct a Chamberlin trimetric projection, where the control points must be distinct.
+func NewChamberlinTrimetric(lat1 float64, lon1 float64, lat2 float64, lon2 float64, lat3 float64, lon3 float64) ChamberlinTrimetric {
+	c := ChamberlinTrimetric{Lat1: lat1, Lon1: lon1, Lat2: lat2, Lon2: lon2, Lat3: lat3, Lon3: lon3}
+	c.controls = [3]Vec3{NewVec3FromLatLon(lat1, lon1), NewVec3FromLatLon(lat2, lon2), NewVec3FromLatLon(lat3, lon3)}
+	for i := range c.controls {
+		c.dists[i] = c.controls[i].AngleTo(c.controls[(i+1)%3])
+		if c.dists[i] == 0 {
+			panic("the control points of a ChamberlinTrimetric projection must be distinct")
+		}
+	}
+	c.guess = NewTwoPointEquidistant(lat1, lon1, lat2, lon2)
+
+	// the third control point lies on the same side of the line from the first to the second as on the sphere
+	angle := planarTriangleAngle(c.dists[0], c.dists[2], c.dists[1])
+	if c.controls[0].Cross(c.controls[1]).Dot(c.controls[2]) < 0 {
+		angle = -angle
+	}
+	third := [2]float64{c.dists[2] * math.Cos(angle), c.dists[2] * math.Sin(angle)}
+	centerX, centerY := (c.dists[0]+third[0])/3, third[1]/3
+	c.planar = [3][2]float64{
+		{-centerX, -centerY},
+		{c.dists[0] - centerX, -centerY},
+		{third[0] - centerX, third[1] - centerY},
+	}
+	return c
+}
+
+// Chamberlin's projection of Africa, as used by the National Geographic Society.
+func NewChamberlinAfrica() ChamberlinTrimetric {
+	return NewChamberlinTrimetric(radians(22), 0, radians(22), radians(45), radians(-22), radians(22.5))
+}
+
+func (c ChamberlinTrimetric) Project(latitude float64, longitude float64) (float64, float64) {
+	p := NewVec3FromLatLon(latitude, longitude)
+	var dists [3]float64
+	for i, control := range c.controls {
+		dists[i] = control.AngleTo(p)
+		if dists[i] == 0 {
+			return c.planar[i][0], c.planar[i][1]
+		}
+	}
+	x, y := 0.0, 0.0
+	for i := range c.controls {
+		j := (i + 1) % 3
+		// turn from the direction of the next control point by the angle of the planar triangle
+		angle := planarTriangleAngle(c.dists[i], dists[i], dists[j])
+		if c.controls[i].Cross(c.controls[j]).Dot(p) < 0 {
+			angle = -angle
+		}
+		sinAngle, cosAngle := math.Sincos(angle)
+		dx := (c.planar[j][0] - c.planar[i][0]) / c.dists[i]
+		dy := (c.planar[j][1] - c.planar[i][1]) / c.dists[i]
+		x += c.planar[i][0] + dists[i]*(dx*cosAngle-dy*sinAngle)
+		y += c.planar[i][1] + dists[i]*(dx*sinAngle+dy*cosAngle)
+	}
+	return x / 3, y / 3
+}
+
+// Finds the location by Newton's method on the plane tangent to the sphere at each estimate, starting from the
+// two-point equidistant projection on the first two control points. Returns NaN if the method does not
+// converge, such as where the projection folds over itself.
+func (c ChamberlinTrimetric) Inverse(x float64, y float64) (float64, float64) {
+	midX, midY := (c.planar[0][0]+c.planar[1][0])/2, (c.planar[0][1]+c.planar[1][1])/2
+	p := NewVec3FromLatLon(c.guess.Inverse(x-midX, y-midY))
+	const step = 1e-7
+	for i := 0; i < 50; i++ {
+		px, py := c.Project(p.LatLon())
+		errX, errY := px-x, py-y
+		if math.Hypot(errX, errY) < 1e-12 {
+			return p.LatLon()
+		}
+		// any two directions along the tangent plane
+		east := NewVec3(0, 0, 1).Cross(p)
+		if east.Norm() < 0.5 {
+			east = NewVec3(1, 0, 0).Cross(p)
+		}
+		east = east.Normalize()
+		north := p.Cross(east)
+		ex, ey := c.Project(p.Add(east.Scale(step)).LatLon())
+		nx, ny := c.Project(p.Add(north.Scale(step)).LatLon())
+		a, b := (ex-px)/step, (nx-px)/step
+		d, e := (ey-py)/step, (ny-py)/step
+		det := determinant(a, b, d, e)
+		if det == 0 {
+			break
+		}
+		du, dv := determinant(errX, b, errY, e)/det, determinant(a, errX, d, errY)/det
+		p = p.Sub(east.Scale(du)).Sub(north.Scale(dv)).Normalize()
+		if math.Hypot(du, dv) < 1e-12 {
+			return p.LatLon()
+		}
+	}
+	return math.NaN(), math.NaN()
+}
+
+// Each projected point is the average of three points at most Pi from the control points, whose centroid is
+// at the origin.
+func (c ChamberlinTrimetric) PlanarBounds() Bounds {
+	return NewCircleBounds(math.Pi)
+}
+
+func (c ChamberlinTrimetric) Describe() Metadata {
+	return Metadata{
+		Name:     "Chamberlin trimetric",
+		Family:   FamilyOther,
+		Shape:    ShapeOther,
+		Extent:   ExtentRegional,
+		PROJName: "chamb",
+	}
+}
+
+// Nudges a point which rounding has left just outside of the ellipse with the given semiaxes back onto it.
+func withinEllipse(x float64, y float64, semiaxisX float64, semiaxisY float64) (float64, float64) {
+	for (x*x)/(semiaxisX*semiaxisX)+(y*y)/(semiaxisY*semiaxisY) > 1 {
+		x, y = math.Nextafter(x, 0), math.Nextafter(y, 0)
+	}
+	return x, y
+}
+
+// The angle (in radians) between the two given sides of a planar triangle, by the half-angle formula.
+func planarTriangleAngle(side1 float64, side2 float64, opposite float64) float64 {
+	s := (side1 + side2 + opposite) / 2
+	return 2 * math.Atan2(math.Sqrt(math.Max(0, (s-side1)*(s-side2))), math.Sqrt(math.Max(0, s*(s-opposite))))
+}
+
+// A projection of the hemisphere around the midpoint of two control points, in which the directions from both
+// control points to every other location are true. It is the gnomonic projection centered on the midpoint,
+// compressed along the line through the control points, which lie on the x axis either side of the origin,
+// so that great circles remain straight lines. Locations more than Pi/2 from the midpoint project to NaN.
+// https://en.wikipedia.org/wiki/Two-point_equidistant_projection
+type TwoPointAzimuthal struct {
+	Lat1 float64 // The latitude (in radians) of the first control point, on the negative x axis.
+	Lon1 float64 // The longitude (in radians) of the first control point, on the negative x axis.
+	Lat2 float64 // The latitude (in radians) of the second control point, on the positive x axis.
+	Lon2 float64 // The longitude (in radians) of the second control point, on the positive x axis.
+
+	rotation Rotation // cached rotation moving the midpoint to (0, 0) with the second control point due east
+	compress float64  // cached compression along the x axis
+}
+
+// Construct a two-point azimuthal projection, where the control points must be distinct and not antipodal.
+func NewTwoPointAzimuthal(lat1 float64, lon1 float64, lat2 float64, lon2 float64) TwoPointAzimuthal {
+	dist := GreatCircleDistance(lat1, lon1, lat2, lon2)
+	if dist < 1e-12 || dist > math.Pi-1e-12 {
+		panic("the control points of a TwoPointAzimuthal projection must be distinct and not antipodal")
+	}
+	midLat, midLon := IntermediatePoint(lat1, lon1, lat2, lon2, 0.5)
+	azimuth := InitialAzimuth(midLat, midLon, lat2, lon2)
+	return TwoPointAzimuthal{
+		Lat1:     lat1,
+		Lon1:     lon1,
+		Lat2:     lat2,
+		Lon2:     lon2,
+		rotation: NewRotationFromCenter(midLat, midLon, azimuth-math.Pi/2),
+		compress: math.Cos(dist / 2),
+	}
+}
+
+func (t TwoPointAzimuthal) Project(latitude float64, longitude float64) (float64, float64) {
+	px, py, pz := t.rotation.ApplyVector(sphericalToCartesian(latitude, longitude))
+	if px <= 0 {
+		return math.NaN(), math.NaN()
+	}
+	return t.compress * py / px, pz / px
+}
+
+func (t TwoPointAzimuthal) Inverse(x float64, y float64) (float64, float64) {
+	return cartesianToSpherical(t.rotation.ApplyInverseVector(1, x/t.compress, y))
+}
+
+func (t TwoPointAzimuthal) PlanarBounds() Bounds {
+	return RectangleBounds{
+		XMin: math.Inf(-1),
+		YMin: math.Inf(-1),
+		XMax: math.Inf(1),
+		YMax: math.Inf(1),
+	}
+}
+
+func (t TwoPointAzimuthal) Describe() Metadata {
+	return Metadata{
+		Name:       "Two-point azimuthal",
+		Aliases:    []string{"Doubly azimuthal"},
+		Family:     FamilyAzimuthal,
+		Properties: StraightGreatCircles,
+		Shape:      ShapeUnbounded,
+		Extent:     ExtentHemisphere,
+	}
+}
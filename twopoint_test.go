@@ -0,0 +1,134 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTwoPointEquidistantTrueDistances(t *testing.T) {
+	testCases := []struct {
+		name string
+		proj TwoPointEquidistant
+	}{
+		{"Equatorial", NewTwoPointEquidistant(0, -0.5, 0, 0.5)},
+		{"Oblique", NewTwoPointEquidistant(0.2, -1.5, 0.8, 2.0)},
+		{"Polar", NewTwoPointEquidistant(math.Pi/2, 0, radians(-30), radians(60))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			x1, y1 := tc.proj.Project(tc.proj.Lat1, tc.proj.Lon1)
+			x2, y2 := tc.proj.Project(tc.proj.Lat2, tc.proj.Lon2)
+			if !withinTolerance(y1, 0, 1e-12) || !withinTolerance(y2, 0, 1e-12) || x1 >= 0 || x2 <= 0 {
+				t.Errorf("expected control points on the x axis either side of the origin, got %f,%f and %f,%f", x1, y1, x2, y2)
+			}
+			for lat := -85.0; lat < 86; lat += 8.5 {
+				for lon := -175.0; lon < 176; lon += 17.5 {
+					rlat, rlon := radians(lat), radians(lon)
+					x, y := tc.proj.Project(rlat, rlon)
+					d1 := GreatCircleDistance(tc.proj.Lat1, tc.proj.Lon1, rlat, rlon)
+					d2 := GreatCircleDistance(tc.proj.Lat2, tc.proj.Lon2, rlat, rlon)
+					if !withinTolerance(math.Hypot(x-x1, y-y1), d1, 1e-9) || !withinTolerance(math.Hypot(x-x2, y-y2), d2, 1e-9) {
+						t.Errorf("expected distances %f and %f from the control points to %f,%f, got %f and %f", d1, d2, lat, lon, math.Hypot(x-x1, y-y1), math.Hypot(x-x2, y-y2))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestChamberlinTrimetricControlPoints(t *testing.T) {
+	proj := NewChamberlinAfrica()
+	lats := []float64{proj.Lat1, proj.Lat2, proj.Lat3}
+	lons := []float64{proj.Lon1, proj.Lon2, proj.Lon3}
+	xs, ys := make([]float64, 3), make([]float64, 3)
+	for i := range lats {
+		xs[i], ys[i] = proj.Project(lats[i], lons[i])
+	}
+	if !withinTolerance(ys[0], ys[1], 1e-12) || xs[0] >= xs[1] {
+		t.Errorf("expected the first two control points on a horizontal line, got %f,%f and %f,%f", xs[0], ys[0], xs[1], ys[1])
+	}
+	if !withinTolerance(xs[0]+xs[1]+xs[2], 0, 1e-12) || !withinTolerance(ys[0]+ys[1]+ys[2], 0, 1e-12) {
+		t.Errorf("expected the control points centered on the origin, got %v,%v", xs, ys)
+	}
+	for i := range lats {
+		j := (i + 1) % 3
+		expected := GreatCircleDistance(lats[i], lons[i], lats[j], lons[j])
+		if actual := math.Hypot(xs[i]-xs[j], ys[i]-ys[j]); !withinTolerance(actual, expected, 1e-12) {
+			t.Errorf("expected control points %d and %d to be %f apart, got %f", i, j, expected, actual)
+		}
+	}
+}
+
+func TestChamberlinTrimetricNearlyTrueDistances(t *testing.T) {
+	proj := NewChamberlinAfrica()
+	x1, y1 := proj.Project(proj.Lat1, proj.Lon1)
+	for lat := -35.0; lat <= 35; lat += 5 {
+		for lon := -15.0; lon <= 50; lon += 5 {
+			rlat, rlon := radians(lat), radians(lon)
+			x, y := proj.Project(rlat, rlon)
+			expected := GreatCircleDistance(proj.Lat1, proj.Lon1, rlat, rlon)
+			if actual := math.Hypot(x-x1, y-y1); math.Abs(actual-expected) > 0.04*math.Max(expected, 0.1) {
+				t.Errorf("expected distance near %f from the first control point to %f,%f, got %f", expected, lat, lon, actual)
+			}
+			rlat2, rlon2 := proj.Inverse(x, y)
+			if !withinTolerance(rlat, rlat2, 1e-9) || !withinTolerance(rlon, rlon2, 1e-9) {
+				t.Errorf("expected %f,%f to invert back, got %f,%f", lat, lon, rlat2*180/math.Pi, rlon2*180/math.Pi)
+			}
+		}
+	}
+}
+
+func TestTwoPointAzimuthalTrueAzimuths(t *testing.T) {
+	proj := NewTwoPointAzimuthal(radians(40), radians(-74), radians(51), radians(0))
+	lats := []float64{proj.Lat1, proj.Lat2}
+	lons := []float64{proj.Lon1, proj.Lon2}
+	for i := range lats {
+		cx, cy := proj.Project(lats[i], lons[i])
+		if !withinTolerance(cy, 0, 1e-12) {
+			t.Errorf("expected control point %d on the x axis, got %f,%f", i, cx, cy)
+		}
+		refLat, refLon := radians(45), radians(-40)
+		rx, ry := proj.Project(refLat, refLon)
+		refAzimuth := InitialAzimuth(lats[i], lons[i], refLat, refLon)
+		refAngle := math.Atan2(ry-cy, rx-cx)
+		for lat := 0.0; lat <= 80; lat += 10 {
+			for lon := -90.0; lon <= 30; lon += 15 {
+				rlat, rlon := radians(lat), radians(lon)
+				if GreatCircleDistance(lats[i], lons[i], rlat, rlon) < 1e-6 {
+					continue
+				}
+				x, y := proj.Project(rlat, rlon)
+				// azimuths turn clockwise while planar angles turn counterclockwise
+				expected := coerceAngle(refAzimuth - InitialAzimuth(lats[i], lons[i], rlat, rlon))
+				actual := coerceAngle(math.Atan2(y-cy, x-cx) - refAngle)
+				if !withinTolerance(actual, expected, 1e-9) {
+					t.Errorf("expected angle %f at control point %d toward %f,%f, got %f", expected, i, lat, lon, actual)
+				}
+			}
+		}
+	}
+}
+
+func TestTwoPointAzimuthalStraightGreatCircles(t *testing.T) {
+	proj := NewTwoPointAzimuthal(radians(40), radians(-74), radians(51), radians(0))
+	startLat, startLon, endLat, endLon := radians(10), radians(-60), radians(70), radians(20)
+	sx, sy := proj.Project(startLat, startLon)
+	ex, ey := proj.Project(endLat, endLon)
+	for f := 0.1; f < 1; f += 0.1 {
+		lat, lon := IntermediatePoint(startLat, startLon, endLat, endLon, f)
+		x, y := proj.Project(lat, lon)
+		if cross := determinant(ex-sx, x-sx, ey-sy, y-sy); !withinTolerance(cross, 0, 1e-9) {
+			t.Errorf("expected %f along the great circle to project onto a straight line, got %f,%f", f, x, y)
+		}
+	}
+}
+
+func TestTwoPointAzimuthalHidden(t *testing.T) {
+	proj := NewTwoPointAzimuthal(radians(40), radians(-74), radians(51), radians(0))
+	midLat, midLon := IntermediatePoint(proj.Lat1, proj.Lon1, proj.Lat2, proj.Lon2, 0.5)
+	x, y := proj.Project(-midLat, midLon+math.Pi)
+	if !math.IsNaN(x) || !math.IsNaN(y) {
+		t.Errorf("expected the antipode of the midpoint to be hidden, got %f,%f", x, y)
+	}
+}
@@ -0,0 +1,218 @@
+package flatsphere
+
+import (
+	"math"
+)
+
+// The Universal Transverse Mercator system, which divides the Earth between 80 degrees south and 84 degrees
+// north into 60 zones, each 6 degrees of longitude wide and mapped with an ellipsoidal transverse Mercator
+// projection. Projected coordinates are eastings and northings in meters (for the default WGS84 ellipsoid),
+// including the false easting of 500 kilometers, and a false northing of 10000 kilometers in the southern
+// hemisphere. Longitudes are absolute, not relative to the zone's central meridian.
+// https://en.wikipedia.org/wiki/Universal_Transverse_Mercator_coordinate_system
+type UTM struct {
+	Zone      int       // The zone number, from 1 to 60.
+	South     bool      // Whether northings are measured for the southern hemisphere.
+	Ellipsoid Ellipsoid // The ellipsoid approximating the Earth, WGS84 by default.
+
+	tm TransverseMercator
+}
+
+const (
+	utmScaleFactor   = 0.9996
+	utmFalseEasting  = 500000
+	utmFalseNorthing = 10000000
+)
+
+// Construct the UTM projection for the given zone and hemisphere on the WGS84 ellipsoid.
+func NewUTM(zone int, south bool) UTM {
+	return NewUTMOnEllipsoid(zone, south, NewWGS84())
+}
+
+// Construct the UTM projection for the given zone and hemisphere on the given ellipsoid.
+func NewUTMOnEllipsoid(zone int, south bool, ellipsoid Ellipsoid) UTM {
+	centralMeridian := radians(float64(6*zone - 183))
+	return UTM{
+		Zone:      zone,
+		South:     south,
+		Ellipsoid: ellipsoid,
+		tm:        NewTransverseMercatorOnEllipsoid(centralMeridian, 0, utmScaleFactor, ellipsoid),
+	}
+}
+
+// Construct the UTM projection for the zone and hemisphere containing the given location (in radians) on the
+// WGS84 ellipsoid, including the exceptional zones around Norway and Svalbard. Locations outside of the UTM
+// latitude limits are given the zone they would otherwise have; use UPS for them instead.
+func NewUTMAt(lat float64, lon float64) UTM {
+	zone, _ := UTMZone(lat, lon)
+	return NewUTM(zone, lat < 0)
+}
+
+// The longitude (in radians) of the central meridian of the zone.
+func (u UTM) CentralMeridian() float64 {
+	return radians(float64(6*u.Zone - 183))
+}
+
+func (u UTM) Project(lat float64, lon float64) (float64, float64) {
+	x, y := u.tm.Project(lat, lon)
+	x += utmFalseEasting
+	if u.South {
+		y += utmFalseNorthing
+	}
+	return x, y
+}
+
+func (u UTM) Inverse(x float64, y float64) (float64, float64) {
+	if u.South {
+		y -= utmFalseNorthing
+	}
+	return u.tm.Inverse(x-utmFalseEasting, y)
+}
+
+// The extent of the zone's nominal 6 degree width, between the equator and the UTM latitude limit of the
+// zone's hemisphere. The exceptional zones around Norway and Svalbard extend a little further.
+func (u UTM) PlanarBounds() Bounds {
+	halfWidth := radians(3)
+	xMin, _ := u.Project(0, u.CentralMeridian()-halfWidth)
+	xMax, _ := u.Project(0, u.CentralMeridian()+halfWidth)
+	if u.South {
+		_, yMin := u.Project(radians(-80), u.CentralMeridian()+halfWidth)
+		return RectangleBounds{XMin: xMin, XMax: xMax, YMin: yMin, YMax: utmFalseNorthing}
+	}
+	_, yMax := u.Project(radians(84), u.CentralMeridian()+halfWidth)
+	return RectangleBounds{XMin: xMin, XMax: xMax, YMin: 0, YMax: yMax}
+}
+
+func (u UTM) Describe() Metadata {
+	return Metadata{
+		Name:       "Universal Transverse Mercator",
+		Aliases:    []string{"UTM"},
+		Family:     FamilyCylindrical,
+		Aspect:     AspectTransverse,
+		Properties: Conformal,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentRegional,
+		EPSGMethod: 9807,
+		PROJName:   "utm",
+	}
+}
+
+// Find the UTM zone number and latitude band letter containing the given location (in radians), including
+// the exceptional zones around Norway and Svalbard. The band letter is zero for locations outside of the
+// UTM latitude limits of 80 degrees south and 84 degrees north, which are covered by UPS instead.
+func UTMZone(lat float64, lon float64) (zone int, band byte) {
+	// round away the error of converting whole degrees to radians and back, so that locations given exactly on
+	// the zone and band edges fall on the side the edge belongs to
+	latDeg := math.Round(degrees(lat)*1e9) / 1e9
+	lonDeg := math.Round(degrees(coerceAngle(lon))*1e9) / 1e9
+	if lonDeg >= 180 {
+		lonDeg -= 360
+	}
+	zone = int(math.Floor((lonDeg+180)/6)) + 1
+	zone = min(max(zone, 1), 60)
+
+	band = utmBand(latDeg)
+	switch {
+	case band == 'V' && zone == 31 && lonDeg >= 3:
+		zone = 32
+	case band == 'X' && lonDeg >= 0 && lonDeg < 42:
+		// Svalbard is covered by the odd zones 31 to 37, each 12 degrees wide
+		zone = 2*int(math.Floor((lonDeg+3)/12)) + 31
+	}
+	return zone, band
+}
+
+const utmBands = "CDEFGHJKLMNPQRSTUVWX"
+
+// The latitude band letter for the given latitude in degrees, or zero outside of the UTM latitude limits.
+func utmBand(latDeg float64) byte {
+	if latDeg < -80 || latDeg > 84 {
+		return 0
+	}
+	index := min(int(math.Floor((latDeg+80)/8)), len(utmBands)-1)
+	return utmBands[index]
+}
+
+// The Universal Polar Stereographic system, covering the polar regions beyond the UTM latitude limits with
+// an ellipsoidal polar stereographic projection with a scale factor of 0.994 at the pole. Projected
+// coordinates are eastings and northings in meters (for the default WGS84 ellipsoid), with the pole at a
+// false easting and northing of 2000 kilometers. Longitude 0 runs toward the bottom of the north polar map
+// and toward the top of the south polar map.
+// https://en.wikipedia.org/wiki/Universal_polar_stereographic_coordinate_system
+type UPS struct {
+	South     bool      // Whether the projection is centered on the south pole rather than the north pole.
+	Ellipsoid Ellipsoid // The ellipsoid approximating the Earth, WGS84 by default.
+}
+
+const (
+	upsScaleFactor = 0.994
+	upsFalseOrigin = 2000000
+)
+
+// Construct the UPS projection for the given pole on the WGS84 ellipsoid.
+func NewUPS(south bool) UPS {
+	return UPS{South: south, Ellipsoid: NewWGS84()}
+}
+
+// The distance from the pole on the plane per unit of the tangent of half the conformal colatitude.
+func (u UPS) radiusScale() float64 {
+	e := math.Sqrt(u.Ellipsoid.EccentricitySquared())
+	c := math.Sqrt(math.Pow(1+e, 1+e) * math.Pow(1-e, 1-e))
+	return 2 * u.Ellipsoid.SemiMajorAxis * upsScaleFactor / c
+}
+
+func (u UPS) Project(lat float64, lon float64) (float64, float64) {
+	if u.South {
+		lat = -lat
+	}
+	// the tangent of half the conformal colatitude
+	taup := u.Ellipsoid.conformalTan(math.Tan(lat))
+	t := 1 / (taup + math.Hypot(1, taup))
+	rho := u.radiusScale() * t
+	if u.South {
+		return upsFalseOrigin + rho*math.Sin(lon), upsFalseOrigin + rho*math.Cos(lon)
+	}
+	return upsFalseOrigin + rho*math.Sin(lon), upsFalseOrigin - rho*math.Cos(lon)
+}
+
+func (u UPS) Inverse(x float64, y float64) (float64, float64) {
+	dx, dy := x-upsFalseOrigin, y-upsFalseOrigin
+	t := math.Hypot(dx, dy) / u.radiusScale()
+	lat := math.Pi / 2
+	if t > 0 {
+		lat = math.Atan(u.Ellipsoid.geodeticTan((1/t - t) / 2))
+	}
+	if u.South {
+		return -lat, math.Atan2(dx, dy)
+	}
+	return lat, math.Atan2(dx, -dy)
+}
+
+// The extent of the map out to the UTM latitude limit, with a little overlap.
+func (u UPS) PlanarBounds() Bounds {
+	limit := radians(83.5)
+	if u.South {
+		limit = radians(-79.5)
+	}
+	x, _ := u.Project(limit, math.Pi/2)
+	radius := x - upsFalseOrigin
+	return RectangleBounds{
+		XMin: upsFalseOrigin - radius,
+		XMax: upsFalseOrigin + radius,
+		YMin: upsFalseOrigin - radius,
+		YMax: upsFalseOrigin + radius,
+	}
+}
+
+func (u UPS) Describe() Metadata {
+	return Metadata{
+		Name:       "Universal Polar Stereographic",
+		Aliases:    []string{"UPS"},
+		Family:     FamilyAzimuthal,
+		Properties: Conformal | Azimuthal,
+		Shape:      ShapeRectangle,
+		Extent:     ExtentRegional,
+		EPSGMethod: 9810,
+		PROJName:   "ups",
+	}
+}
@@ -0,0 +1,160 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUTMProject(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proj     UTM
+		lat      float64
+		lon      float64
+		easting  float64
+		northing float64
+	}{
+		{"Origin", NewUTM(31, false), 0, 0, 166021.443081, 0},
+		{"OriginSouth", NewUTM(31, true), 0, 0, 166021.443081, 10000000},
+		{"CentralMeridian", NewUTM(31, false), 0, 3 * math.Pi / 180, 500000, 0},
+		{"Sydney", NewUTM(56, true), -33.8688 * math.Pi / 180, 151.2093 * math.Pi / 180, 334368.6336, 6250948.3454},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			easting, northing := tc.proj.Project(tc.lat, tc.lon)
+			if !withinTolerance(easting, tc.easting, 0.001) || !withinTolerance(northing, tc.northing, 0.001) {
+				t.Errorf("expected %f,%f, got %f,%f", tc.easting, tc.northing, easting, northing)
+			}
+			lat, lon := tc.proj.Inverse(easting, northing)
+			if !withinTolerance(lat, tc.lat, 1e-12) || !withinTolerance(lon, tc.lon, 1e-12) {
+				t.Errorf("expected %e,%e, got %e,%e", tc.lat, tc.lon, lat, lon)
+			}
+		})
+	}
+}
+
+func TestUTMCentralMeridianScale(t *testing.T) {
+	geodesic := NewWGS84Geodesic()
+	utm := NewUTM(33, false)
+	for _, lat := range []float64{0.1, 0.5, 1.0, 1.4} {
+		distance, _, _ := geodesic.Inverse(0, utm.CentralMeridian(), lat, utm.CentralMeridian())
+		_, northing := utm.Project(lat, utm.CentralMeridian())
+		if !withinTolerance(northing, utmScaleFactor*distance, 0.000001) {
+			t.Errorf("expected northing %f at %f, got %f", utmScaleFactor*distance, lat, northing)
+		}
+	}
+}
+
+func TestUTMZone(t *testing.T) {
+	testCases := []struct {
+		name string
+		lat  float64
+		lon  float64
+		zone int
+		band byte
+	}{
+		{"Origin", 0, 0, 31, 'N'},
+		{"JustWest", 0, -0.001, 30, 'N'},
+		{"Antimeridian", -10, 180, 1, 'L'},
+		{"FarEast", 10, 179.9, 60, 'P'},
+		{"Bergen", 60.39, 5.32, 32, 'V'},
+		{"WestOfBergen", 60.39, 2.9, 31, 'V'},
+		{"BergenEdge", 56, 3, 32, 'V'},
+		{"BandVBottom", 56, 2, 31, 'V'},
+		{"Svalbard33Edge", 78, 9, 33, 'X'},
+		{"Svalbard35Edge", 78, 21, 35, 'X'},
+		{"Svalbard37Edge", 78, 33, 37, 'X'},
+		{"Svalbard31", 78, 8.9, 31, 'X'},
+		{"Svalbard33", 78, 15, 33, 'X'},
+		{"Svalbard35", 78, 25, 35, 'X'},
+		{"Svalbard37", 78, 40, 37, 'X'},
+		{"EastOfSvalbard", 78, 42, 38, 'X'},
+		{"BandXTop", 84, 10, 33, 'X'},
+		{"BandCBottom", -80, 10, 32, 'C'},
+		{"NorthPolar", 85, 10, 32, 0},
+		{"SouthPolar", -81, 10, 32, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			zone, band := UTMZone(tc.lat*math.Pi/180, tc.lon*math.Pi/180)
+			if zone != tc.zone || band != tc.band {
+				t.Errorf("expected %d%c, got %d%c", tc.zone, tc.band, zone, band)
+			}
+		})
+	}
+}
+
+func TestUPSProject(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proj     UPS
+		lat      float64
+		lon      float64
+		easting  float64
+		northing float64
+	}{
+		{"NorthPole", NewUPS(false), math.Pi / 2, 0, 2000000, 2000000},
+		{"SouthPole", NewUPS(true), -math.Pi / 2, 0, 2000000, 2000000},
+		{"North", NewUPS(false), 85 * math.Pi / 180, 0, 2000000, 1444542.608617},
+		{"South", NewUPS(true), -85 * math.Pi / 180, 0, 2000000, 2555457.391383},
+		{"NorthEast", NewUPS(false), 85 * math.Pi / 180, math.Pi / 2, 2555457.391383, 2000000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			easting, northing := tc.proj.Project(tc.lat, tc.lon)
+			if !withinTolerance(easting, tc.easting, 0.001) || !withinTolerance(northing, tc.northing, 0.001) {
+				t.Errorf("expected %f,%f, got %f,%f", tc.easting, tc.northing, easting, northing)
+			}
+			lat, _ := tc.proj.Inverse(easting, northing)
+			if !withinTolerance(lat, tc.lat, 1e-12) {
+				t.Errorf("expected latitude %e, got %e", tc.lat, lat)
+			}
+		})
+	}
+}
+
+func FuzzUTMProjectInverse(f *testing.F) {
+	f.Add(0.0, 0.0)
+	f.Add(1.2, 0.05)
+	f.Add(-1.3, -0.05)
+	f.Fuzz(func(t *testing.T, lat float64, lon float64) {
+		if math.IsNaN(lat) || math.IsNaN(lon) || math.IsInf(lat, 0) || math.IsInf(lon, 0) {
+			return
+		}
+		// within the nominal extent of the zone
+		lat = math.Mod(lat, 80*math.Pi/180)
+		lon = math.Mod(lon, 3*math.Pi/180)
+		utm := NewUTM(17, lat < 0)
+		lon += utm.CentralMeridian()
+		x, y := utm.Project(lat, lon)
+		if !utm.PlanarBounds().Within(x, y) {
+			t.Errorf("expected %f,%f to be within bounds", x, y)
+		}
+		lat2, lon2 := utm.Inverse(x, y)
+		if !withinTolerance(lat, lat2, 1e-12) || !withinTolerance(lon, lon2, 1e-12) {
+			t.Errorf("expected %e,%e, got %e,%e", lat, lon, lat2, lon2)
+		}
+	})
+}
+
+func FuzzUPSProjectInverse(f *testing.F) {
+	f.Add(1.5, 0.0)
+	f.Add(-1.45, 2.0)
+	f.Fuzz(func(t *testing.T, lat float64, lon float64) {
+		if math.IsNaN(lat) || math.IsNaN(lon) || math.IsInf(lat, 0) || math.IsInf(lon, 0) {
+			return
+		}
+		// beyond the UTM latitude limits
+		lat = math.Copysign(80*math.Pi/180+math.Mod(math.Abs(lat), 10*math.Pi/180), lat)
+		lon = math.Mod(lon, math.Pi)
+		ups := NewUPS(lat < 0)
+		x, y := ups.Project(lat, lon)
+		lat2, lon2 := ups.Inverse(x, y)
+		if !withinTolerance(lat, lat2, 1e-12) || (math.Abs(lat) < math.Pi/2-1e-9 && !withinTolerance(lon, lon2, 1e-9)) {
+			t.Errorf("expected %e,%e, got %e,%e", lat, lon, lat2, lon2)
+		}
+	})
+}
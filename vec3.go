@@ -0,0 +1,162 @@
+package flatsphere
+
+import "math"
+
+// A vector in the three dimensional cartesian space around the sphere, where the x axis points to
+// latitude/longitude (0, 0), the y axis to (0, Pi/2), and the z axis to the north pole. Locations on the
+// unit sphere are unit vectors; on an ellipsoid, this is the Earth-centered, Earth-fixed (ECEF) frame.
+type Vec3 struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+func NewVec3(x float64, y float64, z float64) Vec3 {
+	return Vec3{X: x, Y: y, Z: z}
+}
+
+// The unit vector pointing to the given location (in radians) on the unit sphere.
+func NewVec3FromLatLon(lat float64, lon float64) Vec3 {
+	x, y, z := sphericalToCartesian(lat, lon)
+	return Vec3{x, y, z}
+}
+
+// The location (in radians) on the sphere in the direction of the vector, which need not be of unit length.
+func (v Vec3) LatLon() (lat float64, lon float64) {
+	return cartesianToSpherical(v.X, v.Y, v.Z)
+}
+
+func (v Vec3) Add(w Vec3) Vec3 {
+	return Vec3{v.X + w.X, v.Y + w.Y, v.Z + w.Z}
+}
+
+func (v Vec3) Sub(w Vec3) Vec3 {
+	return Vec3{v.X - w.X, v.Y - w.Y, v.Z - w.Z}
+}
+
+func (v Vec3) Scale(s float64) Vec3 {
+	return Vec3{v.X * s, v.Y * s, v.Z * s}
+}
+
+func (v Vec3) Dot(w Vec3) float64 {
+	return v.X*w.X + v.Y*w.Y + v.Z*w.Z
+}
+
+func (v Vec3) Cross(w Vec3) Vec3 {
+	x, y, z := cross(v.X, v.Y, v.Z, w.X, w.Y, w.Z)
+	return Vec3{x, y, z}
+}
+
+// The length of the vector.
+func (v Vec3) Norm() float64 {
+	return math.Sqrt(v.X*v.X + v.Y*v.Y + v.Z*v.Z)
+}
+
+// The unit vector in the same direction as the vector.
+func (v Vec3) Normalize() Vec3 {
+	return v.Scale(1 / v.Norm())
+}
+
+// The angle (in radians) between two vectors, which for unit vectors is the great-circle distance between
+// their locations on the unit sphere. Accurate for both small and large angles.
+func (v Vec3) AngleTo(w Vec3) float64 {
+	return math.Atan2(v.Cross(w).Norm(), v.Dot(w))
+}
+
+// Rotate a vector.
+func (r Rotation) RotateVec3(v Vec3) Vec3 {
+	x, y, z := r.ApplyVector(v.X, v.Y, v.Z)
+	return Vec3{x, y, z}
+}
+
+// Project the location on the sphere in the direction of the vector with the given projection.
+func ProjectVec3(proj Projection, v Vec3) (x float64, y float64) {
+	return proj.Project(v.LatLon())
+}
+
+// Find the unit vector pointing to the location on the sphere at the given planar coordinate of the projection.
+func InverseVec3(proj Projection, x float64, y float64) Vec3 {
+	return NewVec3FromLatLon(proj.Inverse(x, y))
+}
+
+// Convert a geodetic latitude and longitude (in radians) and height above the ellipsoid into Earth-centered,
+// Earth-fixed cartesian coordinates, in the units of the ellipsoid's semi-major axis.
+func (e Ellipsoid) GeodeticToECEF(lat float64, lon float64, height float64) Vec3 {
+	e2 := e.EccentricitySquared()
+	sinLat, cosLat := math.Sincos(lat)
+	sinLon, cosLon := math.Sincos(lon)
+	// the radius of curvature in the prime vertical
+	n := e.SemiMajorAxis / math.Sqrt(1-e2*sinLat*sinLat)
+	return Vec3{
+		X: (n + height) * cosLat * cosLon,
+		Y: (n + height) * cosLat * sinLon,
+		Z: (n*(1-e2) + height) * sinLat,
+	}
+}
+
+// Convert Earth-centered, Earth-fixed cartesian coordinates into a geodetic latitude and longitude (in radians)
+// and height above the ellipsoid, using the closed form solution of Vermeille (2011). Accurate to well below a
+// millimeter on Earth-sized ellipsoids, except within a few kilometers of the center of the ellipsoid.
+func (e Ellipsoid) ECEFToGeodetic(v Vec3) (lat float64, lon float64, height float64) {
+	a := e.SemiMajorAxis
+	e2 := e.EccentricitySquared()
+	e4 := e2 * e2
+	rho := math.Hypot(v.X, v.Y)
+
+	p := rho * rho / (a * a)
+	q := (1 - e2) / (a * a) * v.Z * v.Z
+	r := (p + q - e4) / 6
+	s := e4 * p * q / (4 * r * r * r)
+	t := math.Cbrt(1 + s + math.Sqrt(s*(2+s)))
+	u := r * (1 + t + 1/t)
+	w0 := math.Sqrt(u*u + e4*q)
+	w := e2 * (u + w0 - q) / (2 * w0)
+	k := math.Sqrt(u+w0+w*w) - w
+	d := k * rho / (k + e2)
+
+	dist := math.Hypot(d, v.Z)
+	lat = 2 * math.Atan2(v.Z, d+dist)
+	lon = math.Atan2(v.Y, v.X)
+	height = (k + e2 - 1) / k * dist
+	return lat, lon, height
+}
+
+// A local tangent plane frame at a location on an ellipsoid, with axes pointing east, north and up (along the
+// ellipsoid normal). Useful for working with positions relative to an observer.
+type ENUFrame struct {
+	Origin Vec3 // The Earth-centered, Earth-fixed position of the frame's origin.
+	East   Vec3 // The unit vector pointing east at the origin.
+	North  Vec3 // The unit vector pointing north at the origin.
+	Up     Vec3 // The unit vector pointing up, normal to the ellipsoid, at the origin.
+}
+
+// Construct the east-north-up frame at the given geodetic latitude and longitude (in radians) and height
+// above the ellipsoid.
+func NewENUFrame(ellipsoid Ellipsoid, lat float64, lon float64, height float64) ENUFrame {
+	sinLat, cosLat := math.Sincos(lat)
+	sinLon, cosLon := math.Sincos(lon)
+	return ENUFrame{
+		Origin: ellipsoid.GeodeticToECEF(lat, lon, height),
+		East:   Vec3{-sinLon, cosLon, 0},
+		North:  Vec3{-sinLat * cosLon, -sinLat * sinLon, cosLat},
+		Up:     Vec3{cosLat * cosLon, cosLat * sinLon, sinLat},
+	}
+}
+
+// Convert an Earth-centered, Earth-fixed position into east, north and up offsets from the frame's origin.
+func (f ENUFrame) FromECEF(v Vec3) Vec3 {
+	offset := v.Sub(f.Origin)
+	return Vec3{offset.Dot(f.East), offset.Dot(f.North), offset.Dot(f.Up)}
+}
+
+// Convert east, north and up offsets from the frame's origin into an Earth-centered, Earth-fixed position.
+func (f ENUFrame) ToECEF(enu Vec3) Vec3 {
+	return f.Origin.Add(f.East.Scale(enu.X)).Add(f.North.Scale(enu.Y)).Add(f.Up.Scale(enu.Z))
+}
+
+// The azimuth (in radians clockwise from north), elevation above the local horizon (in radians) and
+// straight-line distance from the frame's origin to the given Earth-centered, Earth-fixed position.
+func (f ENUFrame) LookAngles(v Vec3) (azimuth float64, elevation float64, distance float64) {
+	enu := f.FromECEF(v)
+	return math.Atan2(enu.X, enu.Y), math.Atan2(enu.Z, math.Hypot(enu.X, enu.Y)), enu.Norm()
+}
@@ -0,0 +1,110 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVec3LatLon(t *testing.T) {
+	for _, loc := range [][2]float64{{0, 0}, {0.5, -2.0}, {-1.2, 3.0}, {math.Pi / 2, 0}} {
+		v := NewVec3FromLatLon(loc[0], loc[1])
+		if !withinTolerance(v.Norm(), 1, 0.000001) {
+			t.Errorf("expected unit vector, got length %e", v.Norm())
+		}
+		lat, lon := v.Scale(3).LatLon()
+		if !withinTolerance(lat, loc[0], 0.000001) || !withinTolerance(lon, loc[1], 0.000001) {
+			t.Errorf("expected %e,%e, got %e,%e", loc[0], loc[1], lat, lon)
+		}
+	}
+
+	a, b := NewVec3FromLatLon(0.3, 0.4), NewVec3FromLatLon(-0.9, 2.2)
+	if !withinTolerance(a.AngleTo(b), GreatCircleDistance(0.3, 0.4, -0.9, 2.2), 0.000001) {
+		t.Errorf("expected angle to match great circle distance, got %e", a.AngleTo(b))
+	}
+}
+
+func TestGeodeticECEF(t *testing.T) {
+	wgs84 := NewWGS84()
+	testCases := []struct {
+		name     string
+		lat      float64
+		lon      float64
+		height   float64
+		expected Vec3
+	}{
+		{"Origin", 0, 0, 0, Vec3{6378137, 0, 0}},
+		{"NorthPole", math.Pi / 2, 0, 0, Vec3{0, 0, wgs84.SemiMinorAxis()}},
+		{"EquatorHigh", 0, math.Pi / 2, 1000, Vec3{0, 6379137, 0}},
+		{"SouthPoleDeep", -math.Pi / 2, 1.0, -500, Vec3{0, 0, -wgs84.SemiMinorAxis() + 500}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := wgs84.GeodeticToECEF(tc.lat, tc.lon, tc.height)
+			if !withinTolerance(v.X, tc.expected.X, 0.000001) || !withinTolerance(v.Y, tc.expected.Y, 0.000001) || !withinTolerance(v.Z, tc.expected.Z, 0.000001) {
+				t.Errorf("expected %v, got %v", tc.expected, v)
+			}
+		})
+	}
+}
+
+func FuzzGeodeticECEFRoundTrip(f *testing.F) {
+	wgs84 := NewWGS84()
+	f.Add(0.0, 0.0, 0.0)
+	f.Add(0.7, -2.0, 8848.0)
+	f.Add(-1.5, 3.0, -400.0)
+	f.Add(0.3, 1.0, 35786000.0)
+	f.Fuzz(func(t *testing.T, lat float64, lon float64, height float64) {
+		lat = math.Mod(lat, math.Pi/2)
+		lon = math.Mod(lon, math.Pi)
+		height = math.Mod(height, 40000000)
+		if math.IsNaN(lat+lon+height) || height < -100000 {
+			t.Skip()
+		}
+		rlat, rlon, rheight := wgs84.ECEFToGeodetic(wgs84.GeodeticToECEF(lat, lon, height))
+		// compare positions as distances on the ground, as longitude is meaningless at the poles
+		if !withinTolerance(rlat, lat, 1e-12) || !withinTolerance(rheight, height, 1e-6) || math.Abs(coerceAngle(rlon-lon))*math.Cos(lat) > 1e-12 {
+			t.Errorf("expected %e,%e,%e, got %e,%e,%e", lat, lon, height, rlat, rlon, rheight)
+		}
+	})
+}
+
+func TestENUFrame(t *testing.T) {
+	wgs84 := NewWGS84()
+	lat, lon := 0.8, -1.3
+	frame := NewENUFrame(wgs84, lat, lon, 50)
+
+	above := wgs84.GeodeticToECEF(lat, lon, 150)
+	if enu := frame.FromECEF(above); !withinTolerance(enu.X, 0, 0.000001) || !withinTolerance(enu.Y, 0, 0.000001) || !withinTolerance(enu.Z, 100, 0.000001) {
+		t.Errorf("expected 0,0,100, got %v", enu)
+	}
+
+	enu := Vec3{120, -40, 7}
+	if back := frame.FromECEF(frame.ToECEF(enu)); !withinTolerance(back.X, enu.X, 0.000001) || !withinTolerance(back.Y, enu.Y, 0.000001) || !withinTolerance(back.Z, enu.Z, 0.000001) {
+		t.Errorf("expected %v, got %v", enu, back)
+	}
+
+	// a point a little to the north is seen due north, just below the horizon due to the curvature of the ellipsoid
+	north := wgs84.GeodeticToECEF(lat+0.001, lon, 50)
+	azimuth, elevation, _ := frame.LookAngles(north)
+	if !withinTolerance(azimuth, 0, 0.000001) || elevation >= 0 || elevation < -0.001 {
+		t.Errorf("expected north on the horizon, got azimuth %e elevation %e", azimuth, elevation)
+	}
+}
+
+func TestProjectVec3(t *testing.T) {
+	proj := NewRobinson()
+	v := NewVec3FromLatLon(0.4, -1.1)
+	x, y := ProjectVec3(proj, v)
+	back := InverseVec3(proj, x, y)
+	if !withinTolerance(back.AngleTo(v), 0, 0.000001) {
+		t.Errorf("expected %v, got %v", v, back)
+	}
+
+	rotated := NewRotationFromPole(0.2, 0.3, 0.4).RotateVec3(v)
+	lat, lon := rotated.LatLon()
+	elat, elon := NewRotationFromPole(0.2, 0.3, 0.4).Apply(0.4, -1.1)
+	if !withinTolerance(lat, elat, 0.000001) || !withinTolerance(lon, elon, 0.000001) {
+		t.Errorf("expected %e,%e, got %e,%e", elat, elon, lat, lon)
+	}
+}
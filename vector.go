@@ -0,0 +1,58 @@
+package flatsphere
+
+import "math"
+
+// Transform a tangent vector at a location on the sphere, given as eastward and northward components u and v,
+// into the corresponding planar vector (dx, dy) of the projection. The planar vector is the image of the
+// tangent vector under the projection's local linear approximation (its Jacobian), so it is both rotated and
+// scaled by the local distortion of the map. Useful for drawing vector fields such as winds or currents.
+func ProjectVector(proj Projection, lat float64, lon float64, u float64, v float64) (dx float64, dy float64) {
+	xe, ye, xn, yn := projectionJacobian(proj, lat, lon)
+	return xe*u + xn*v, ye*u + yn*v
+}
+
+// Transform a planar vector (dx, dy) at a point on the projection plane into the eastward and northward
+// components u and v of the corresponding tangent vector on the sphere. The reverse of ProjectVector. Returns
+// NaN components where the projection is singular.
+func InverseVector(proj Projection, x float64, y float64, dx float64, dy float64) (u float64, v float64) {
+	lat, lon := proj.Inverse(x, y)
+	xe, ye, xn, yn := projectionJacobian(proj, lat, lon)
+	det := xe*yn - xn*ye
+	if det == 0 {
+		return math.NaN(), math.NaN()
+	}
+	return (yn*dx - xn*dy) / det, (xe*dy - ye*dx) / det
+}
+
+// The direction of true north at a point on the projection plane, as a unit planar vector.
+func NorthDirection(proj Projection, x float64, y float64) (dx float64, dy float64) {
+	lat, lon := proj.Inverse(x, y)
+	_, _, xn, yn := projectionJacobian(proj, lat, lon)
+	length := math.Hypot(xn, yn)
+	return xn / length, yn / length
+}
+
+// The grid convergence at a point on the projection plane: the angle in radians from grid north (the
+// planar +y direction) to true north, positive when true north lies clockwise (toward +x) of grid north.
+func GridConvergence(proj Projection, x float64, y float64) float64 {
+	dx, dy := NorthDirection(proj, x, y)
+	return math.Atan2(dx, dy)
+}
+
+// The partial derivatives of planar x and y with respect to eastward and northward distance on the unit
+// sphere at the given location, estimated by central differences. Steps are taken away from the poles,
+// where the east and north directions are undefined.
+func projectionJacobian(proj Projection, lat float64, lon float64) (xe float64, ye float64, xn float64, yn float64) {
+	nudge := 1e-6
+	lat = math.Max(-math.Pi/2+2*nudge, math.Min(math.Pi/2-2*nudge, lat))
+
+	// consider points slightly west and east
+	dLon := nudge / math.Cos(lat)
+	xw, yw := proj.Project(lat, lon-dLon)
+	xE, yE := proj.Project(lat, lon+dLon)
+	// consider points slightly south and north
+	xs, ys := proj.Project(lat-nudge, lon)
+	xN, yN := proj.Project(lat+nudge, lon)
+
+	return (xE - xw) / (2 * nudge), (yE - yw) / (2 * nudge), (xN - xs) / (2 * nudge), (yN - ys) / (2 * nudge)
+}
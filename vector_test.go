@@ -0,0 +1,56 @@
+package flatsphere
+
+import (
+	"math"
+	"testing"
+)
+
+func TestProjectVector(t *testing.T) {
+	// mercator scales both components by the secant of the latitude, without rotation
+	lat := 0.5
+	dx, dy := ProjectVector(NewMercator(), lat, 0.3, 1, 2)
+	if !withinTolerance(dx, 1/math.Cos(lat), 0.000001) || !withinTolerance(dy, 2/math.Cos(lat), 0.000001) {
+		t.Errorf("expected %e,%e, got %e,%e", 1/math.Cos(lat), 2/math.Cos(lat), dx, dy)
+	}
+
+	// a conformal projection preserves the angle between vectors
+	ux, uy := ProjectVector(NewStereographic(), 0.8, 2.0, 1, 0)
+	vx, vy := ProjectVector(NewStereographic(), 0.8, 2.0, 0, 1)
+	if !withinTolerance(ux*vx+uy*vy, 0, 0.000001) || !withinTolerance(math.Hypot(ux, uy), math.Hypot(vx, vy), 0.000001) {
+		t.Errorf("expected orthogonal vectors of equal length, got %e,%e and %e,%e", ux, uy, vx, vy)
+	}
+}
+
+func TestInverseVector(t *testing.T) {
+	projections := []Projection{NewMercator(), NewRobinson(), NewLambertAzimuthal(), NewObliqueProjection(NewSinusoidal(), 0.9, 0.4, 0.2)}
+	for _, proj := range projections {
+		lat, lon := 0.4, -0.7
+		dx, dy := ProjectVector(proj, lat, lon, 3, -1.5)
+		x, y := proj.Project(lat, lon)
+		u, v := InverseVector(proj, x, y, dx, dy)
+		if !withinTolerance(u, 3, 0.0001) || !withinTolerance(v, -1.5, 0.0001) {
+			t.Errorf("expected %T vector 3,-1.5, got %e,%e", proj, u, v)
+		}
+	}
+}
+
+func TestGridConvergence(t *testing.T) {
+	if gamma := GridConvergence(NewPlateCarree(), 1, 0.5); !withinTolerance(gamma, 0, 0.000001) {
+		t.Errorf("expected no convergence for plate carrée, got %e", gamma)
+	}
+
+	// the meridians of a conic projection converge by the cone constant times the longitude
+	conic := NewLambertConformalConic(33*math.Pi/180, 45*math.Pi/180)
+	n, _, _ := conic.cone()
+	lon := 0.6
+	x, y := conic.Project(0.7, lon)
+	if gamma := GridConvergence(conic, x, y); !withinTolerance(gamma, -n*lon, 0.000001) {
+		t.Errorf("expected convergence %e, got %e", -n*lon, gamma)
+	}
+
+	// north points toward the center of a north polar azimuthal projection
+	dx, dy := NorthDirection(NewLambertAzimuthal(), 0.5, 0)
+	if !withinTolerance(dx, -1, 0.000001) || !withinTolerance(dy, 0, 0.000001) {
+		t.Errorf("expected north direction -1,0, got %e,%e", dx, dy)
+	}
+}